@@ -0,0 +1,113 @@
+package gcp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+/**
+ * buildAIP160Filter: To build an AIP-160 filter (https://google.aip.dev/160) from quals,
+ * for the APIs which take a `filter` expression rather than the Compute Engine syntax
+ * Sample for gcp_alloydb_cluster table
+ * select name, state from gcp_alloydb_cluster
+ * where state in ('READY', 'STOPPED') and labels @> '{"env": "prod"}'
+ * -------------------------------------------------------------------------
+ * 	Column: state, Operator: "=", Value: "[READY STOPPED]"
+ * 	Column: labels, Operator: "@>", Value: "{"env": "prod"}"
+ * -------------------------------------------------------------------------
+ *
+ * Output: (state = "READY" OR state = "STOPPED") AND labels.env = "prod"
+ *
+ * The filterQualMap types are the ones supported by buildQueryFilterFromQuals, except that
+ * LIKE is not translated. Each API only supports filtering on some fields, so only map the
+ * columns its list call documents.
+ */
+func buildAIP160Filter(filterQuals []filterQualMap, quals plugin.KeyColumnQualMap) string {
+	var filters []string
+
+	for _, filterQualItem := range filterQuals {
+		filterQual := quals[filterQualItem.ColumnName]
+		if filterQual == nil {
+			continue
+		}
+
+		for _, qual := range filterQual.Quals {
+			if qual.Value == nil {
+				continue
+			}
+			propertyPath := filterQualItem.PropertyPath
+
+			if filterQualItem.Type == "labels" {
+				filters = append(filters, buildLabelFilters(propertyPath, qual.Operator, qual.Value)...)
+				continue
+			}
+
+			// In case of IN (= ANY) or NOT IN (<> ALL) clause, other operators are not pushed down
+			if qual.Value.GetListValue() != nil {
+				var join string
+				switch qual.Operator {
+				case "=":
+					join = " OR "
+				case "<>", "!=":
+					join = " AND "
+				default:
+					continue
+				}
+				var values []string
+				for _, q := range qual.Value.GetListValue().Values {
+					if value, ok := formatAIP160FilterValue(filterQualItem.Type, q); ok {
+						values = append(values, fmt.Sprintf("%s %s %s", propertyPath, aip160FilterOperatorMap[qual.Operator], value))
+					}
+				}
+				if len(values) > 0 {
+					filters = append(filters, "("+strings.Join(values, join)+")")
+				}
+				continue
+			}
+
+			operator, ok := aip160FilterOperatorMap[qual.Operator]
+			if !ok {
+				continue
+			}
+			if value, ok := formatAIP160FilterValue(filterQualItem.Type, qual.Value); ok {
+				filters = append(filters, fmt.Sprintf("%s %s %s", propertyPath, operator, value))
+			}
+		}
+	}
+
+	return strings.Join(filters, " AND ")
+}
+
+// formatAIP160FilterValue formats a qual value of the given filterQualMap type as an AIP-160 literal
+func formatAIP160FilterValue(valueType string, value *proto.QualValue) (string, bool) {
+	switch valueType {
+	case "string":
+		return quoteFilterValue(value.GetStringValue()), true
+	case "boolean":
+		return strconv.FormatBool(value.GetBoolValue()), true
+	case "int64":
+		return formatNumberFilterValue(value), true
+	case "timestamp":
+		if value.GetTimestampValue() == nil {
+			return "", false
+		}
+		return quoteFilterValue(value.GetTimestampValue().AsTime().UTC().Format(time.RFC3339Nano)), true
+	}
+	return "", false
+}
+
+// Steampipe to AIP-160 comparison operator map
+var aip160FilterOperatorMap = map[string]string{
+	"=":  "=",
+	"<>": "!=",
+	"!=": "!=",
+	">":  ">",
+	">=": ">=",
+	"<":  "<",
+	"<=": "<=",
+}
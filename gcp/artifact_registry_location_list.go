@@ -7,7 +7,6 @@ import (
 	"google.golang.org/api/artifactregistry/v1"
 )
 
-
 // BuildregionList :: return a list of matrix items, one per region specified
 func BuildArtifactRegistryLocationList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
 
@@ -21,35 +20,41 @@ func BuildArtifactRegistryLocationList(ctx context.Context, d *plugin.QueryData)
 	// Create Service Connection
 	service, err := ArtifactRegistryService(ctx, d)
 	if err != nil {
-		return nil
+		return []map[string]interface{}{locationListFailure(ctx, d, "BuildArtifactRegistryLocationList", "artifactregistry.projects.locations.list", "", err)}
 	}
 
-	// Get project details
-	projectData, err := activeProject(ctx, d)
+	// Get the projects the connection is scoped to
+	projects, err := getProjectList(ctx, d)
 	if err != nil {
-		return nil
+		return []map[string]interface{}{locationListFailure(ctx, d, "BuildArtifactRegistryLocationList", "artifactregistry.projects.locations.list", "", err)}
 	}
-	project := projectData.Project
 
-	resp := service.Projects.Locations.List("projects/" + project)
-	if err != nil {
-		return nil
+	var matrix, failures []map[string]interface{}
+	for _, project := range projects {
+		var locations []*artifactregistry.Location
+
+		resp := service.Projects.Locations.List("projects/" + project)
+		if err := resp.Pages(ctx, func(page *artifactregistry.ListLocationsResponse) error {
+			locations = append(locations, page.Locations...)
+			return nil
+		}); err != nil {
+			failures = append(failures, locationListFailure(ctx, d, "BuildArtifactRegistryLocationList", "artifactregistry.projects.locations.list", project, err))
+			continue
+		}
+
+		for _, location := range locations {
+			if !isLocationIncluded(d.Connection, location.LocationId) {
+				continue
+			}
+			matrix = append(matrix, map[string]interface{}{matrixKeyProject: project, matrixKeyLocation: location.LocationId})
+		}
 	}
 
-	var locations []*artifactregistry.Location
-
-	if err := resp.Pages(ctx, func(page *artifactregistry.ListLocationsResponse) error {
-		locations = append(locations, page.Locations...)
-		return nil
-	}); err != nil {
-		return nil
+	// Failures are not cached, so the next query lists the locations again
+	if len(failures) > 0 {
+		return append(matrix, failures...)
 	}
 
-	// validate location list
-	matrix := make([]map[string]interface{}, len(locations))
-	for i, location := range locations {
-		matrix[i] = map[string]interface{}{matrixKeyLocation: location.LocationId}
-	}
 	d.ConnectionManager.Cache.Set(locationCacheKey, matrix)
 	return matrix
 }
@@ -0,0 +1,140 @@
+package gcp
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/googleapis/gax-go/v2"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"google.golang.org/api/googleapi"
+)
+
+// errorRetryPolicy is the retry policy of a connection's API clients: an exponential backoff
+// starting at "min_error_retry_delay" and capped at 60 seconds, for at most
+// "max_error_retry_attempts" retries. REST clients apply it in retryTransport and gRPC
+// clients in errorRetryer, so every list, get and hydrate call is retried the same way.
+type errorRetryPolicy struct {
+	maxAttempts int
+	minDelay    time.Duration
+}
+
+func connectionErrorRetryPolicy(connection *plugin.Connection) errorRetryPolicy {
+	gcpConfig := GetConfig(connection)
+	policy := errorRetryPolicy{
+		maxAttempts: defaultMaxErrorRetryAttempts,
+		minDelay:    defaultMinErrorRetryDelay * time.Millisecond,
+	}
+	if gcpConfig.MaxErrorRetryAttempts != nil {
+		policy.maxAttempts = *gcpConfig.MaxErrorRetryAttempts
+	}
+	if gcpConfig.MinErrorRetryDelay != nil {
+		policy.minDelay = time.Duration(*gcpConfig.MinErrorRetryDelay) * time.Millisecond
+	}
+	return policy
+}
+
+// retryDelay returns how long to wait before the given retry (0 for the first) of a request
+// that failed with err, or false if it should not be retried. The delay is never shorter than
+// the Retry-After or RetryInfo delay the server asked for. If that is longer than the backoff
+// cap the request is not retried, as it would only be throttled again.
+func (p errorRetryPolicy) retryDelay(retry int, err error) (time.Duration, bool) {
+	if retry >= p.maxAttempts || !isRetryableError(err) {
+		return 0, false
+	}
+
+	delay := p.minDelay
+	for i := 0; i < retry && delay < maxErrorRetryDelay*time.Millisecond; i++ {
+		delay *= 2
+	}
+	delay = min(delay, maxErrorRetryDelay*time.Millisecond)
+
+	if serverDelay, ok := retryAfter(err); ok {
+		if serverDelay > maxErrorRetryDelay*time.Millisecond {
+			return 0, false
+		}
+		delay = max(delay, serverDelay)
+	}
+	return delay, true
+}
+
+// retryTransport retries the requests of a REST client that fail with a throttling or
+// transient error, see errorRetryPolicy
+type retryTransport struct {
+	base   http.RoundTripper
+	policy errorRetryPolicy
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for retry := 0; ; retry++ {
+		resp, err := t.base.RoundTrip(req)
+		if err != nil || resp.StatusCode < http.StatusBadRequest {
+			return resp, err
+		}
+
+		delay, ok := t.policy.retryDelay(retry, responseError(resp))
+		// a request whose body cannot be read again cannot be retried
+		if !ok || (req.Body != nil && req.GetBody == nil) {
+			return resp, nil
+		}
+		resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// responseError returns the googleapi.Error of an error response, as the generated clients
+// would return it. The body is buffered, so it can still be read by the client.
+func responseError(resp *http.Response) error {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	return googleapi.CheckResponse(&http.Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	})
+}
+
+// errorRetryer retries the calls of a gRPC client that fail with a throttling or transient
+// error, see errorRetryPolicy
+type errorRetryer struct {
+	policy  errorRetryPolicy
+	retries int
+}
+
+func (r *errorRetryer) Retry(err error) (time.Duration, bool) {
+	delay, ok := r.policy.retryDelay(r.retries, err)
+	r.retries++
+	return delay, ok
+}
+
+// grpcRetryCallOption returns the call option which retries the calls of a gRPC client with
+// the connection's retry policy. It is appended to the client's default call options, so it
+// replaces their retry settings and keeps their timeouts.
+func grpcRetryCallOption(connection *plugin.Connection) gax.CallOption {
+	policy := connectionErrorRetryPolicy(connection)
+	return gax.WithRetry(func() gax.Retryer {
+		return &errorRetryer{policy: policy}
+	})
+}
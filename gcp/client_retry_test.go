@@ -0,0 +1,99 @@
+package gcp
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRetryDelay(t *testing.T) {
+	policy := errorRetryPolicy{maxAttempts: 3, minDelay: 25 * time.Millisecond}
+	throttled := &googleapi.Error{Code: http.StatusTooManyRequests}
+
+	tests := []struct {
+		name   string
+		retry  int
+		err    error
+		want   time.Duration
+		wantOk bool
+	}{
+		{"first retry", 0, throttled, 25 * time.Millisecond, true},
+		{"backoff doubles", 2, throttled, 100 * time.Millisecond, true},
+		{"attempts exhausted", 3, throttled, 0, false},
+		{"not retryable", 0, &googleapi.Error{Code: http.StatusNotFound}, 0, false},
+		{"server delay is honoured", 0, &googleapi.Error{Code: 429, Header: http.Header{"Retry-After": {"5"}}}, 5 * time.Second, true},
+		{"server delay above the cap", 0, &googleapi.Error{Code: 429, Header: http.Header{"Retry-After": {"120"}}}, 0, false},
+		{"gRPC RetryInfo", 1, grpcError(codes.ResourceExhausted, 2*time.Second), 2 * time.Second, true},
+	}
+	for _, test := range tests {
+		got, ok := policy.retryDelay(test.retry, test.err)
+		if got != test.want || ok != test.wantOk {
+			t.Errorf("retryDelay(%s) = %v, %t, want %v, %t", test.name, got, ok, test.want, test.wantOk)
+		}
+	}
+
+	// the backoff is capped, however large the delay and retry count
+	long := errorRetryPolicy{maxAttempts: 100, minDelay: time.Minute}
+	if got, _ := long.retryDelay(99, throttled); got != maxErrorRetryDelay*time.Millisecond {
+		t.Errorf("retryDelay(capped) = %v, want %v", got, maxErrorRetryDelay*time.Millisecond)
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			io.WriteString(w, `{"error": {"code": 503, "message": "unavailable"}}`)
+			return
+		}
+		io.WriteString(w, "ok")
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &retryTransport{base: http.DefaultTransport, policy: errorRetryPolicy{maxAttempts: 3, minDelay: time.Millisecond}}}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader("request"))
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || string(body) != "ok" {
+		t.Errorf("response = %d %q, want 200 \"ok\"", resp.StatusCode, body)
+	}
+	if len(bodies) != 3 || bodies[2] != "request" {
+		t.Errorf("server received %q, want the request body three times", bodies)
+	}
+
+	// once the attempts are exhausted the error response is returned with its body
+	bodies = nil
+	client.Transport.(*retryTransport).policy.maxAttempts = 1
+	resp, err = client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if err := googleapi.CheckResponse(resp); err == nil || !strings.Contains(err.Error(), "unavailable") || len(bodies) != 2 {
+		t.Errorf("CheckResponse() = %v after %d requests, want the 503 error after 2 requests", err, len(bodies))
+	}
+
+	// the wait between retries ends with the request's context
+	bodies = nil
+	client.Transport.(*retryTransport).policy = errorRetryPolicy{maxAttempts: 3, minDelay: time.Hour}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	if _, err := client.Do(req); err == nil || !strings.Contains(err.Error(), context.DeadlineExceeded.Error()) {
+		t.Errorf("request error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
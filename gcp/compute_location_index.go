@@ -0,0 +1,163 @@
+package gcp
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"google.golang.org/api/googleapi"
+)
+
+// computeLocationIndexTTL bounds how long a resource created after the index was built can go unseen by a Get
+const computeLocationIndexTTL = 5 * time.Minute
+
+// computeLocationIndex maps the names of a compute resource type in a project to the aggregated
+// list scopes ("zones/us-central1-a", "regions/us-central1" or "global") they are found in
+type computeLocationIndex map[string][]string
+
+func (index computeLocationIndex) add(name string, scope string) {
+	if !slices.Contains(index[name], scope) {
+		index[name] = append(index[name], scope)
+	}
+}
+
+// computeResourceType describes a compute resource type that is listed with an AggregatedList
+type computeResourceType struct {
+	// Name identifies the resource type in the cache key of its location index, e.g. "Disks"
+	Name string
+}
+
+var (
+	computeAddressType              = computeResourceType{Name: "Addresses"}
+	computeAutoscalerType           = computeResourceType{Name: "Autoscalers"}
+	computeBackendServiceType       = computeResourceType{Name: "BackendServices"}
+	computeDiskType                 = computeResourceType{Name: "Disks"}
+	computeForwardingRuleType       = computeResourceType{Name: "ForwardingRules"}
+	computeHaVpnGatewayType         = computeResourceType{Name: "VpnGateways"}
+	computeInstanceType             = computeResourceType{Name: "Instances"}
+	computeInstanceGroupType        = computeResourceType{Name: "InstanceGroups"}
+	computeInstanceGroupManagerType = computeResourceType{Name: "InstanceGroupManagers"}
+	computeNodeGroupType            = computeResourceType{Name: "NodeGroups"}
+	computeNodeTemplateType         = computeResourceType{Name: "NodeTemplates"}
+	computeResourcePolicyType       = computeResourceType{Name: "ResourcePolicies"}
+	computeRouterType               = computeResourceType{Name: "Routers"}
+	computeSubnetworkType           = computeResourceType{Name: "Subnetworks"}
+	computeTargetHttpsProxyType     = computeResourceType{Name: "TargetHttpsProxies"}
+	computeTargetPoolType           = computeResourceType{Name: "TargetPools"}
+	computeTargetVpnGatewayType     = computeResourceType{Name: "TargetVpnGateways"}
+	computeUrlMapType               = computeResourceType{Name: "UrlMaps"}
+	computeVpnTunnelType            = computeResourceType{Name: "VpnTunnels"}
+)
+
+// computeAggregatedList pages through the AggregatedList of a resource type in a project, optionally
+// filtered and limited to a fields mask, and calls fn with the scope, name and *compute.<Type> of
+// each resource. Each table implements it with its typed AggregatedList call, e.g.
+// computeDiskAggregatedList.
+type computeAggregatedList func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error
+
+// computeAggregatedListCall is the call type of an AggregatedList, e.g. *compute.DisksAggregatedListCall
+type computeAggregatedListCall[C any] interface {
+	Filter(filter string) C
+	Fields(s ...googleapi.Field) C
+}
+
+// filterComputeAggregatedList adds the optional filter and fields mask of a computeAggregatedList to its call
+func filterComputeAggregatedList[C computeAggregatedListCall[C]](call C, filter string, fields googleapi.Field) C {
+	if filter != "" {
+		call = call.Filter(filter)
+	}
+	if fields != "" {
+		call = call.Fields(fields)
+	}
+	return call
+}
+
+// computeLocationIndexLocks serializes building the index of each resource type and project,
+// so a join which gets many resources by name lists them only once
+var computeLocationIndexLocks sync.Map
+
+// getComputeResource gets a zonal, regional or global compute resource by name with a direct Get,
+// instead of an AggregatedList filtered by name. get fetches the resource from a scope type
+// ("zones", "regions" or "global") and location.
+//
+// If the query passes a `location` qual, the scope is derived from it. Otherwise the name is looked
+// up in an index of the resource type's locations, which is built once per project and cached for
+// computeLocationIndexTTL. A Get returns a single row, so a name found in several locations falls
+// back to the AggregatedList filtered by name. Returns nil if the resource does not exist.
+func getComputeResource(ctx context.Context, d *plugin.QueryData, project string, resourceType computeResourceType, list computeAggregatedList, name string, get func(scopeType string, location string) (interface{}, error)) (interface{}, error) {
+	var scopes []string
+	if location := d.EqualsQualString("location"); location != "" {
+		scopes = []string{computeLocationScope(location)}
+	} else {
+		index, err := getComputeLocationIndex(ctx, d, project, resourceType, list)
+		if err != nil {
+			return nil, err
+		}
+		scopes = index[name]
+	}
+
+	switch len(scopes) {
+	case 0:
+		return nil, nil
+	case 1:
+		return get(splitComputeScope(scopes[0]))
+	}
+
+	// the pages are maps of scopes, so keep the resource of the first scope for a stable result
+	var resource interface{}
+	var resourceScope string
+	err := list(ctx, "name="+name, "", func(scope string, _ string, item interface{}) {
+		if resource == nil || scope < resourceScope {
+			resource, resourceScope = item, scope
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
+
+// getComputeLocationIndex returns the cached index of the locations of a resource type in a
+// project, building it with an AggregatedList of the names only if needed
+func getComputeLocationIndex(ctx context.Context, d *plugin.QueryData, project string, resourceType computeResourceType, list computeAggregatedList) (computeLocationIndex, error) {
+	cacheKey := "ComputeLocationIndex/" + resourceType.Name + "/" + project
+	lock, _ := computeLocationIndexLocks.LoadOrStore(d.Connection.Name+"/"+cacheKey, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	if index, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return index.(computeLocationIndex), nil
+	}
+
+	index := computeLocationIndex{}
+	err := list(ctx, "", "items/*/*/name,nextPageToken", func(scope string, name string, _ interface{}) {
+		index.add(name, scope)
+	})
+	if err != nil {
+		return nil, err
+	}
+	d.ConnectionManager.Cache.SetWithTTL(cacheKey, index, computeLocationIndexTTL)
+	return index, nil
+}
+
+// computeLocationScope converts a location column value (e.g. "us-central1-a", "us-central1" or
+// "global") to the matching aggregated list scope
+func computeLocationScope(location string) string {
+	switch {
+	case location == "global":
+		return location
+	case strings.Count(location, "-") >= 2:
+		return "zones/" + location
+	default:
+		return "regions/" + location
+	}
+}
+
+// splitComputeScope splits an aggregated list scope into its type ("zones", "regions" or "global") and location
+func splitComputeScope(scope string) (string, string) {
+	scopeType, location, _ := strings.Cut(scope, "/")
+	return scopeType, location
+}
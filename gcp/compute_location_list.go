@@ -26,26 +26,35 @@ func BuildComputeLocationList(ctx context.Context, d *plugin.QueryData) []map[st
 	// Create Service Connection
 	service, err := ComputeService(ctx, d)
 	if err != nil {
-		return nil
+		return []map[string]interface{}{locationListFailure(ctx, d, "BuildComputeLocationList", "compute.regions.list", "", err)}
 	}
 
-	// Get project details
-	projectData, err := activeProject(ctx, d)
+	// Get the projects the connection is scoped to
+	projects, err := getProjectList(ctx, d)
 	if err != nil {
-		return nil
+		return []map[string]interface{}{locationListFailure(ctx, d, "BuildComputeLocationList", "compute.regions.list", "", err)}
 	}
-	project := projectData.Project
 
-	resp, err := service.Regions.List(project).Do()
-	if err != nil {
-		return nil
+	var matrix, failures []map[string]interface{}
+	for _, project := range projects {
+		resp, err := service.Regions.List(project).Do()
+		if err != nil {
+			failures = append(failures, locationListFailure(ctx, d, "BuildComputeLocationList", "compute.regions.list", project, err))
+			continue
+		}
+		for _, location := range resp.Items {
+			if !isLocationIncluded(d.Connection, location.Name) {
+				continue
+			}
+			matrix = append(matrix, map[string]interface{}{matrixKeyProject: project, matrixKeyLocation: location.Name})
+		}
 	}
 
-	// validate location list
-	matrix := make([]map[string]interface{}, len(resp.Items))
-	for i, location := range resp.Items {
-		matrix[i] = map[string]interface{}{matrixKeyLocation: location.Name}
+	// Failures are not cached, so the next query lists the locations again
+	if len(failures) > 0 {
+		return append(matrix, failures...)
 	}
+
 	d.ConnectionManager.Cache.Set(locationCacheKey, matrix)
 	return matrix
 }
@@ -62,29 +71,39 @@ func BuildComputeLocationListWithGlobal(ctx context.Context, d *plugin.QueryData
 	// Create Service Connection
 	service, err := ComputeService(ctx, d)
 	if err != nil {
-		return nil
+		return []map[string]interface{}{locationListFailure(ctx, d, "BuildComputeLocationListWithGlobal", "compute.regions.list", "", err)}
 	}
 
-	// Get project details
-	projectData, err := activeProject(ctx, d)
+	// Get the projects the connection is scoped to
+	projects, err := getProjectList(ctx, d)
 	if err != nil {
-		return nil
+		return []map[string]interface{}{locationListFailure(ctx, d, "BuildComputeLocationListWithGlobal", "compute.regions.list", "", err)}
 	}
-	project := projectData.Project
 
-	resp, err := service.Regions.List(project).Do()
-	if err != nil {
-		return nil
+	var matrix, failures []map[string]interface{}
+	for _, project := range projects {
+		resp, err := service.Regions.List(project).Do()
+		if err != nil {
+			failures = append(failures, locationListFailure(ctx, d, "BuildComputeLocationListWithGlobal", "compute.regions.list", project, err))
+			continue
+		}
+
+		// Add global first
+		matrix = append(matrix, map[string]interface{}{matrixKeyProject: project, matrixKeyLocation: "global"})
+		// Then add all regions
+		for _, location := range resp.Items {
+			if !isLocationIncluded(d.Connection, location.Name) {
+				continue
+			}
+			matrix = append(matrix, map[string]interface{}{matrixKeyProject: project, matrixKeyLocation: location.Name})
+		}
 	}
 
-	// Add global and all regions to the matrix
-	matrix := make([]map[string]interface{}, len(resp.Items)+1)
-	// Add global first
-	matrix[0] = map[string]interface{}{matrixKeyLocation: "global"}
-	// Then add all regions
-	for i, location := range resp.Items {
-		matrix[i+1] = map[string]interface{}{matrixKeyLocation: location.Name}
+	// Failures are not cached, so the next query lists the locations again
+	if len(failures) > 0 {
+		return append(matrix, failures...)
 	}
+
 	d.ConnectionManager.Cache.Set(locationCacheKey, matrix)
 	return matrix
 }
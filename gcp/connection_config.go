@@ -5,13 +5,33 @@ import (
 )
 
 type gcpConfig struct {
-	Project                   *string  `hcl:"project"`
-	Credentials               *string  `hcl:"credentials"`
-	ImpersonateAccessToken    *string  `hcl:"impersonate_access_token"`
-	ImpersonateServiceAccount *string  `hcl:"impersonate_service_account"`
-  	QuotaProject              *string  `hcl:"quota_project,optional"`
-	IgnoreErrorMessages       []string `hcl:"ignore_error_messages,optional"`
-	IgnoreErrorCodes          []string `hcl:"ignore_error_codes,optional"`
+	Project                         *string           `hcl:"project"`
+	Projects                        []string          `hcl:"projects,optional"`
+	Organization                    *string           `hcl:"organization,optional"`
+	Locations                       []string          `hcl:"locations,optional"`
+	Folders                         []string          `hcl:"folders,optional"`
+	Credentials                     *string           `hcl:"credentials"`
+	ImpersonateAccessToken          *string           `hcl:"impersonate_access_token"`
+	ImpersonateServiceAccount       *string           `hcl:"impersonate_service_account"`
+	Delegates                       []string          `hcl:"delegates,optional"`
+	ExternalAccountAudience         *string           `hcl:"external_account_audience,optional"`
+	ExternalAccountSubjectTokenType *string           `hcl:"external_account_subject_token_type,optional"`
+	ExternalAccountTokenFile        *string           `hcl:"external_account_token_file,optional"`
+	ExternalAccountTokenURL         *string           `hcl:"external_account_token_url,optional"`
+	ExternalAccountTokenURLHeaders  map[string]string `hcl:"external_account_token_url_headers,optional"`
+	ExternalAccountTokenFormat      *string           `hcl:"external_account_token_format,optional"`
+	ExternalAccountTokenFieldName   *string           `hcl:"external_account_token_field_name,optional"`
+	ExternalAccountTokenExecutable  *string           `hcl:"external_account_token_executable,optional"`
+	UniverseDomain                  *string           `hcl:"universe_domain,optional"`
+	Endpoints                       map[string]string `hcl:"endpoints,optional"`
+	WithoutAuthentication           *bool             `hcl:"without_authentication,optional"`
+	QuotaProject                    *string           `hcl:"quota_project,optional"`
+	MaxErrorRetryAttempts           *int              `hcl:"max_error_retry_attempts,optional"`
+	MinErrorRetryDelay              *int              `hcl:"min_error_retry_delay,optional"`
+	IgnoreErrorMessages             []string          `hcl:"ignore_error_messages,optional"`
+	IgnoreErrorCodes                []string          `hcl:"ignore_error_codes,optional"`
+	IgnoreErrorReasons              []string          `hcl:"ignore_error_reasons,optional"`
+	IgnoreDisabledServices          *bool             `hcl:"ignore_disabled_services,optional"`
 }
 
 func ConfigInstance() interface{} {
@@ -26,5 +46,3 @@ func GetConfig(connection *plugin.Connection) gcpConfig {
 	config, _ := connection.Config.(gcpConfig)
 	return config
 }
-
-
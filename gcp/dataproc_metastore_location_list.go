@@ -6,8 +6,6 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 )
 
-
-
 // BuildDataprocMetastoreLocationList :: return a list of matrix items, one per region specified
 func BuildDataprocMetastoreLocationList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
 
@@ -21,26 +19,35 @@ func BuildDataprocMetastoreLocationList(ctx context.Context, d *plugin.QueryData
 	// Create Service Connection
 	service, err := DataprocMetastoreService(ctx, d)
 	if err != nil {
-		return nil
+		return []map[string]interface{}{locationListFailure(ctx, d, "BuildDataprocMetastoreLocationList", "metastore.projects.locations.list", "", err)}
 	}
 
-	// Get project details
-	projectData, err := activeProject(ctx, d)
+	// Get the projects the connection is scoped to
+	projects, err := getProjectList(ctx, d)
 	if err != nil {
-		return nil
+		return []map[string]interface{}{locationListFailure(ctx, d, "BuildDataprocMetastoreLocationList", "metastore.projects.locations.list", "", err)}
 	}
-	project := projectData.Project
 
-	resp, err := service.Projects.Locations.List("projects/" + project).Do()
-	if err != nil {
-		return nil
+	var matrix, failures []map[string]interface{}
+	for _, project := range projects {
+		resp, err := service.Projects.Locations.List("projects/" + project).Do()
+		if err != nil {
+			failures = append(failures, locationListFailure(ctx, d, "BuildDataprocMetastoreLocationList", "metastore.projects.locations.list", project, err))
+			continue
+		}
+		for _, location := range resp.Locations {
+			if !isLocationIncluded(d.Connection, location.LocationId) {
+				continue
+			}
+			matrix = append(matrix, map[string]interface{}{matrixKeyProject: project, matrixKeyLocation: location.LocationId})
+		}
 	}
 
-	// validate location list
-	var matrix []map[string]interface{}
-	for _, location := range resp.Locations {
-		matrix = append(matrix, map[string]interface{}{matrixKeyLocation: location.LocationId})
+	// Failures are not cached, so the next query lists the locations again
+	if len(failures) > 0 {
+		return append(matrix, failures...)
 	}
+
 	d.ConnectionManager.Cache.Set(locationCacheKey, matrix)
 	return matrix
 }
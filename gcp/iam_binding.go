@@ -0,0 +1,179 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+// iamBindingColumns returns the columns of a *_iam_binding table, after the columns identifying its resource
+func iamBindingColumns(columns []*plugin.Column) []*plugin.Column {
+	columns = append(columns, commonIamBindingColumns()...)
+	return append(columns, iamConditionColumns()...)
+}
+
+func commonIamBindingColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "member",
+			Description: "The principal granted the role, e.g. user:alice@example.com.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "member_type",
+			Description: "The type of the principal, e.g. user, serviceAccount, group, domain or allUsers.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "role",
+			Description: "The role granted to the member.",
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "condition_title",
+			Description: "The title of the condition of the binding, if any.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("ConditionTitle").NullIfZero(),
+		},
+		{
+			Name:        "condition_description",
+			Description: "The description of the condition of the binding, if any.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("ConditionDescription").NullIfZero(),
+		},
+		{
+			Name:        "condition_expression",
+			Description: "The CEL expression of the condition of the binding, if any.",
+			Type:        proto.ColumnType_STRING,
+			Transform:   transform.FromField("ConditionExpression").NullIfZero(),
+		},
+		{
+			Name:        "location",
+			Description: ColumnDescriptionLocation,
+			Type:        proto.ColumnType_STRING,
+		},
+		{
+			Name:        "project",
+			Description: ColumnDescriptionProject,
+			Type:        proto.ColumnType_STRING,
+		},
+	}
+}
+
+// iamBindingRow is one member of an IAM policy binding
+type iamBindingRow struct {
+	Member               string
+	MemberType           string
+	Role                 string
+	ConditionTitle       string
+	ConditionDescription string
+	ConditionExpression  string
+}
+
+// iamResourceBinding is a row of a *_iam_binding table
+type iamResourceBinding struct {
+	iamBindingRow
+	ResourceName string
+	Location     string
+	Project      string
+}
+
+// iamMemberType returns the type of an IAM policy member, e.g. "user" for "user:alice@example.com",
+// "allUsers" for "allUsers" or "deleted:serviceAccount" for a deleted service account
+func iamMemberType(member string) string {
+	memberType, _, found := strings.Cut(member, ":")
+	if !found {
+		return member
+	}
+	if memberType == "deleted" {
+		deletedType, _, _ := strings.Cut(strings.TrimPrefix(member, "deleted:"), ":")
+		return memberType + ":" + deletedType
+	}
+	return memberType
+}
+
+// newIAMBindingRows flattens a binding into one row per member
+func newIAMBindingRows(members []string, role string, conditionTitle string, conditionDescription string, conditionExpression string) []iamBindingRow {
+	rows := make([]iamBindingRow, 0, len(members))
+	for _, member := range members {
+		rows = append(rows, iamBindingRow{
+			Member:               member,
+			MemberType:           iamMemberType(member),
+			Role:                 role,
+			ConditionTitle:       conditionTitle,
+			ConditionDescription: conditionDescription,
+			ConditionExpression:  conditionExpression,
+		})
+	}
+	return rows
+}
+
+// iamPolicyBindingRows flattens the bindings of an IAM policy into one row per member. Each API
+// client has its own Policy type, but they all share the JSON representation of an IAM policy,
+// so the policy is decoded through it.
+func iamPolicyBindingRows(policy interface{}) ([]iamBindingRow, error) {
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	var iamPolicy struct {
+		Bindings []struct {
+			Members   []string `json:"members"`
+			Role      string   `json:"role"`
+			Condition *struct {
+				Title       string `json:"title"`
+				Description string `json:"description"`
+				Expression  string `json:"expression"`
+			} `json:"condition"`
+		} `json:"bindings"`
+	}
+	if err := json.Unmarshal(data, &iamPolicy); err != nil {
+		return nil, err
+	}
+
+	var rows []iamBindingRow
+	for _, binding := range iamPolicy.Bindings {
+		var conditionTitle, conditionDescription, conditionExpression string
+		if binding.Condition != nil {
+			conditionTitle, conditionDescription, conditionExpression = binding.Condition.Title, binding.Condition.Description, binding.Condition.Expression
+		}
+		rows = append(rows, newIAMBindingRows(binding.Members, binding.Role, conditionTitle, conditionDescription, conditionExpression)...)
+	}
+	return rows, nil
+}
+
+// streamIamPolicyBindings streams one row per member of each binding of a resource's IAM policy.
+// It returns false once the query needs no more rows.
+func streamIamPolicyBindings(ctx context.Context, d *plugin.QueryData, policy interface{}, resourceName string, location string, project string) (bool, error) {
+	if policy == nil {
+		return true, nil
+	}
+
+	rows, err := iamPolicyBindingRows(policy)
+	if err != nil {
+		return false, err
+	}
+
+	for _, row := range rows {
+		d.StreamListItem(ctx, &iamResourceBinding{
+			iamBindingRow: row,
+			ResourceName:  resourceName,
+			Location:      location,
+			Project:       project,
+		})
+
+		// Check if context has been cancelled or if the limit has been hit (if specified)
+		// if there is a limit, it will return the number of rows required to reach this limit
+		if d.RowsRemaining(ctx) == 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,192 @@
+package gcp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	celast "github.com/google/cel-go/common/ast"
+	"github.com/google/cel-go/common/operators"
+	celtypes "github.com/google/cel-go/common/types"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// iamConditionEnv parses the CEL expressions of IAM conditions. Parsing needs no declarations,
+// so one environment is shared.
+// https://cloud.google.com/iam/docs/conditions-attribute-reference
+var iamConditionEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv()
+})
+
+// iamConditionTag is a tag that a resource must have for a conditional binding to apply
+type iamConditionTag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	// True for resource.matchTagId, where the key and value are tagKeys/ and tagValues/ IDs
+	// rather than namespaced names
+	ById bool `json:"by_id"`
+}
+
+//// TABLE DEFINITION
+
+// iamConditionColumns returns the columns parsed from the `ConditionExpression` of a binding row
+func iamConditionColumns() []*plugin.Column {
+	return []*plugin.Column{
+		{
+			Name:        "condition_expires_at",
+			Description: "The time the binding expires, parsed from a request.time < timestamp(...) clause of the condition. Null if the condition uses ! or ||.",
+			Type:        proto.ColumnType_TIMESTAMP,
+			Transform:   transform.FromField("ConditionExpression").Transform(iamConditionExpiresAt),
+		},
+		{
+			Name:        "condition_resource_prefixes",
+			Description: "The resource name prefixes the binding is limited to, parsed from resource.name.startsWith(...) clauses of the condition. Null if the condition uses ! or ||.",
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.FromField("ConditionExpression").Transform(iamConditionResourcePrefixes),
+		},
+		{
+			Name:        "condition_tags",
+			Description: "The tags the binding is limited to, parsed from resource.matchTag(...) and resource.matchTagId(...) clauses of the condition. Null if the condition uses ! or ||.",
+			Type:        proto.ColumnType_JSON,
+			Transform:   transform.FromField("ConditionExpression").Transform(iamConditionTags),
+		},
+	}
+}
+
+//// TRANSFORM FUNCTIONS
+
+// iamConditionExpiresAt returns the earliest request.time upper bound of a condition, or nil
+// if it has none
+func iamConditionExpiresAt(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var expiresAt *time.Time
+	for _, clause := range iamConditionClauses(types.SafeString(d.Value)) {
+		if clause.Kind() != celast.CallKind {
+			continue
+		}
+		call := clause.AsCall()
+		if (call.FunctionName() != operators.Less && call.FunctionName() != operators.LessEquals) || !isIamConditionSelect(call.Args()[0], "request", "time") {
+			continue
+		}
+		bound := call.Args()[1]
+		if bound.Kind() != celast.CallKind || bound.AsCall().FunctionName() != "timestamp" || len(bound.AsCall().Args()) != 1 {
+			continue
+		}
+		value, ok := iamConditionString(bound.AsCall().Args()[0])
+		if !ok {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			continue
+		}
+		if expiresAt == nil || t.Before(*expiresAt) {
+			expiresAt = &t
+		}
+	}
+	if expiresAt == nil {
+		return nil, nil
+	}
+	return *expiresAt, nil
+}
+
+func iamConditionResourcePrefixes(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var prefixes []string
+	for _, clause := range iamConditionClauses(types.SafeString(d.Value)) {
+		if clause.Kind() != celast.CallKind {
+			continue
+		}
+		call := clause.AsCall()
+		if call.FunctionName() != "startsWith" || !call.IsMemberFunction() || !isIamConditionSelect(call.Target(), "resource", "name") || len(call.Args()) != 1 {
+			continue
+		}
+		if prefix, ok := iamConditionString(call.Args()[0]); ok {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes, nil
+}
+
+func iamConditionTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	var tags []iamConditionTag
+	for _, clause := range iamConditionClauses(types.SafeString(d.Value)) {
+		if clause.Kind() != celast.CallKind {
+			continue
+		}
+		call := clause.AsCall()
+		if (call.FunctionName() != "matchTag" && call.FunctionName() != "matchTagId") || !call.IsMemberFunction() || len(call.Args()) != 2 {
+			continue
+		}
+		if target := call.Target(); target.Kind() != celast.IdentKind || target.AsIdent() != "resource" {
+			continue
+		}
+		key, keyOk := iamConditionString(call.Args()[0])
+		value, valueOk := iamConditionString(call.Args()[1])
+		if keyOk && valueOk {
+			tags = append(tags, iamConditionTag{Key: key, Value: value, ById: call.FunctionName() == "matchTagId"})
+		}
+	}
+	return tags, nil
+}
+
+//// UTILITY FUNCTIONS
+
+// iamConditionClauses returns the top-level conjuncts of a condition, i.e. the clauses which all
+// have to hold for the binding to apply. It returns nil if the condition does not parse, or if it
+// uses ! or ||, as a clause under either no longer restricts the binding on its own.
+func iamConditionClauses(expression string) []celast.Expr {
+	if expression == "" {
+		return nil
+	}
+	env, err := iamConditionEnv()
+	if err != nil {
+		return nil
+	}
+	parsed, issues := env.Parse(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil
+	}
+	root := parsed.NativeRep().Expr()
+
+	negatedOrDisjunct := false
+	celast.PostOrderVisit(root, celast.NewExprVisitor(func(e celast.Expr) {
+		if e.Kind() == celast.CallKind && (e.AsCall().FunctionName() == operators.LogicalNot || e.AsCall().FunctionName() == operators.LogicalOr) {
+			negatedOrDisjunct = true
+		}
+	}))
+	if negatedOrDisjunct {
+		return nil
+	}
+	return iamConditionConjuncts(root)
+}
+
+func iamConditionConjuncts(e celast.Expr) []celast.Expr {
+	if e.Kind() == celast.CallKind && e.AsCall().FunctionName() == operators.LogicalAnd {
+		var clauses []celast.Expr
+		for _, arg := range e.AsCall().Args() {
+			clauses = append(clauses, iamConditionConjuncts(arg)...)
+		}
+		return clauses
+	}
+	return []celast.Expr{e}
+}
+
+// isIamConditionSelect returns true if e selects field of the variable operand, e.g. request.time
+func isIamConditionSelect(e celast.Expr, operand string, field string) bool {
+	if e.Kind() != celast.SelectKind || e.AsSelect().FieldName() != field {
+		return false
+	}
+	return e.AsSelect().Operand().Kind() == celast.IdentKind && e.AsSelect().Operand().AsIdent() == operand
+}
+
+// iamConditionString returns the value of a string literal
+func iamConditionString(e celast.Expr) (string, bool) {
+	if e.Kind() != celast.LiteralKind {
+		return "", false
+	}
+	value, ok := e.AsLiteral().(celtypes.String)
+	return string(value), ok
+}
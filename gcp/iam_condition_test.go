@@ -0,0 +1,71 @@
+package gcp
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+func TestIamConditionColumns(t *testing.T) {
+	expiry := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		expression string
+		expiresAt  interface{}
+		prefixes   []string
+		tags       []iamConditionTag
+	}{
+		{
+			expression: `request.time < timestamp("2026-01-01T00:00:00Z")`,
+			expiresAt:  expiry,
+		},
+		{
+			expression: `request.time <= timestamp('2027-01-01T00:00:00Z') && (request.time < timestamp("2026-01-01T00:00:00Z") && resource.name.startsWith("projects/_/buckets/logs"))`,
+			expiresAt:  expiry,
+			prefixes:   []string{"projects/_/buckets/logs"},
+		},
+		{
+			expression: `resource.matchTag("123/env", "prod") && resource.matchTagId("tagKeys/1", "tagValues/2")`,
+			tags:       []iamConditionTag{{Key: "123/env", Value: "prod"}, {Key: "tagKeys/1", Value: "tagValues/2", ById: true}},
+		},
+		{
+			// A clause under || does not restrict the binding on its own
+			expression: `request.time < timestamp("2026-01-01T00:00:00Z") || resource.name.startsWith("projects/_/buckets/logs")`,
+		},
+		{
+			// nor does a negated one
+			expression: `!resource.name.startsWith("projects/_/buckets/logs") && resource.matchTag("123/env", "prod")`,
+		},
+		{
+			// Only top-level conjuncts are extracted
+			expression: `resource.type == "storage.googleapis.com/Bucket" ? resource.name.startsWith("projects/_/buckets/logs") : true`,
+		},
+		{
+			expression: `request.time < timestamp("not a time") && resource.name.startsWith(`,
+		},
+		{
+			expression: "",
+		},
+	}
+
+	ctx := context.Background()
+	for _, test := range tests {
+		d := &transform.TransformData{Value: test.expression}
+
+		expiresAt, _ := iamConditionExpiresAt(ctx, d)
+		if !reflect.DeepEqual(expiresAt, test.expiresAt) {
+			t.Errorf("iamConditionExpiresAt(%q) = %v, want %v", test.expression, expiresAt, test.expiresAt)
+		}
+		prefixes, _ := iamConditionResourcePrefixes(ctx, d)
+		if !reflect.DeepEqual(prefixes, test.prefixes) {
+			t.Errorf("iamConditionResourcePrefixes(%q) = %v, want %v", test.expression, prefixes, test.prefixes)
+		}
+		tags, _ := iamConditionTags(ctx, d)
+		if !reflect.DeepEqual(tags, test.tags) {
+			t.Errorf("iamConditionTags(%q) = %v, want %v", test.expression, tags, test.tags)
+		}
+	}
+}
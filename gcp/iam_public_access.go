@@ -0,0 +1,128 @@
+package gcp
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/bigquery/v2"
+)
+
+// iamPublicRole is a role granted to everyone, or to everyone in a domain
+type iamPublicRole struct {
+	Member string `json:"member"`
+	Role   string `json:"role"`
+}
+
+// isPublicIamMember returns true for the members that grant access to anyone on the internet,
+// authenticated or not
+func isPublicIamMember(member string) bool {
+	return member == "allUsers" || member == "allAuthenticatedUsers"
+}
+
+// isDomainIamMember returns true for the members that grant access to every account of a
+// Google Workspace or Cloud Identity domain
+func isDomainIamMember(member string) bool {
+	return strings.HasPrefix(member, "domain:")
+}
+
+// getIamPolicyPublicRoles returns the roles an IAM policy grants to allUsers,
+// allAuthenticatedUsers or a whole domain, sorted by member and role
+func getIamPolicyPublicRoles(policy interface{}) ([]iamPublicRole, error) {
+	rows, err := iamPolicyBindingRows(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	var publicRoles []iamPublicRole
+	for _, row := range rows {
+		if isPublicIamMember(row.Member) || isDomainIamMember(row.Member) {
+			publicRoles = append(publicRoles, iamPublicRole{Member: row.Member, Role: row.Role})
+		}
+	}
+	sortIamPublicRoles(publicRoles)
+	return publicRoles, nil
+}
+
+// getBigQueryDatasetPublicRoles returns the roles the access entries of a dataset grant to
+// allUsers, allAuthenticatedUsers or a whole domain. Depending on how access was granted, the
+// public members are either in the specialGroup or the iamMember of an entry.
+func getBigQueryDatasetPublicRoles(access []*bigquery.DatasetAccess) []iamPublicRole {
+	var publicRoles []iamPublicRole
+	for _, entry := range access {
+		if entry == nil {
+			continue
+		}
+		var member string
+		switch {
+		case isPublicIamMember(entry.SpecialGroup):
+			member = entry.SpecialGroup
+		case isPublicIamMember(entry.IamMember), isDomainIamMember(entry.IamMember):
+			member = entry.IamMember
+		case entry.Domain != "":
+			member = "domain:" + entry.Domain
+		default:
+			continue
+		}
+		publicRoles = append(publicRoles, iamPublicRole{Member: member, Role: entry.Role})
+	}
+	sortIamPublicRoles(publicRoles)
+	return publicRoles
+}
+
+func sortIamPublicRoles(publicRoles []iamPublicRole) {
+	sort.Slice(publicRoles, func(i, j int) bool {
+		if publicRoles[i].Member != publicRoles[j].Member {
+			return publicRoles[i].Member < publicRoles[j].Member
+		}
+		return publicRoles[i].Role < publicRoles[j].Role
+	})
+}
+
+func iamPublicRolesArePublic(publicRoles []iamPublicRole) bool {
+	for _, publicRole := range publicRoles {
+		if isPublicIamMember(publicRole.Member) {
+			return true
+		}
+	}
+	return false
+}
+
+//// TRANSFORM FUNCTIONS
+
+// iamPolicyIsPublic returns true if the IAM policy grants any role to allUsers or
+// allAuthenticatedUsers. Domain-wide grants are listed in public_roles, but are not public.
+func iamPolicyIsPublic(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.Value == nil {
+		return nil, nil
+	}
+	publicRoles, err := getIamPolicyPublicRoles(d.Value)
+	if err != nil {
+		return nil, err
+	}
+	return iamPublicRolesArePublic(publicRoles), nil
+}
+
+func iamPolicyPublicRoles(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if d.Value == nil {
+		return nil, nil
+	}
+	return getIamPolicyPublicRoles(d.Value)
+}
+
+func bigQueryDatasetIsPublic(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	access, ok := d.Value.([]*bigquery.DatasetAccess)
+	if !ok {
+		return nil, nil
+	}
+	return iamPublicRolesArePublic(getBigQueryDatasetPublicRoles(access)), nil
+}
+
+func bigQueryDatasetPublicRoles(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	access, ok := d.Value.([]*bigquery.DatasetAccess)
+	if !ok {
+		return nil, nil
+	}
+	return getBigQueryDatasetPublicRoles(access), nil
+}
@@ -0,0 +1,93 @@
+package gcp
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/api/bigquery/v2"
+	"google.golang.org/api/compute/v1"
+)
+
+func TestGetIamPolicyPublicRoles(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   interface{}
+		want     []iamPublicRole
+		isPublic bool
+	}{
+		{
+			name: "public members",
+			policy: &compute.Policy{Bindings: []*compute.Binding{
+				{Role: "roles/viewer", Members: []string{"user:a@example.com", "allUsers"}},
+				{Role: "roles/editor", Members: []string{"allAuthenticatedUsers"}},
+			}},
+			want:     []iamPublicRole{{Member: "allAuthenticatedUsers", Role: "roles/editor"}, {Member: "allUsers", Role: "roles/viewer"}},
+			isPublic: true,
+		},
+		{
+			name: "domain members are listed but not public",
+			policy: &compute.Policy{Bindings: []*compute.Binding{
+				{Role: "roles/viewer", Members: []string{"domain:example.com", "group:team@example.com"}},
+			}},
+			want: []iamPublicRole{{Member: "domain:example.com", Role: "roles/viewer"}},
+		},
+		{
+			name: "private members",
+			policy: &compute.Policy{Bindings: []*compute.Binding{
+				{Role: "roles/owner", Members: []string{"serviceAccount:sa@example.iam.gserviceaccount.com"}},
+			}},
+		},
+	}
+	for _, test := range tests {
+		got, err := getIamPolicyPublicRoles(test.policy)
+		if err != nil {
+			t.Fatalf("getIamPolicyPublicRoles(%s) error: %v", test.name, err)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("getIamPolicyPublicRoles(%s) = %v, want %v", test.name, got, test.want)
+		}
+		if isPublic := iamPublicRolesArePublic(got); isPublic != test.isPublic {
+			t.Errorf("iamPublicRolesArePublic(%s) = %t, want %t", test.name, isPublic, test.isPublic)
+		}
+	}
+}
+
+func TestGetBigQueryDatasetPublicRoles(t *testing.T) {
+	tests := []struct {
+		name     string
+		access   []*bigquery.DatasetAccess
+		want     []iamPublicRole
+		isPublic bool
+	}{
+		{
+			name:     "special group",
+			access:   []*bigquery.DatasetAccess{{Role: "READER", SpecialGroup: "allAuthenticatedUsers"}, {Role: "OWNER", SpecialGroup: "projectOwners"}},
+			want:     []iamPublicRole{{Member: "allAuthenticatedUsers", Role: "READER"}},
+			isPublic: true,
+		},
+		{
+			name:     "IAM member",
+			access:   []*bigquery.DatasetAccess{{Role: "roles/bigquery.dataViewer", IamMember: "allUsers"}, {Role: "WRITER", IamMember: "user:a@example.com"}},
+			want:     []iamPublicRole{{Member: "allUsers", Role: "roles/bigquery.dataViewer"}},
+			isPublic: true,
+		},
+		{
+			name:   "domain",
+			access: []*bigquery.DatasetAccess{{Role: "READER", Domain: "example.com"}, {Role: "WRITER", IamMember: "domain:example.org"}, nil},
+			want:   []iamPublicRole{{Member: "domain:example.com", Role: "READER"}, {Member: "domain:example.org", Role: "WRITER"}},
+		},
+		{
+			name:   "private entries",
+			access: []*bigquery.DatasetAccess{{Role: "OWNER", UserByEmail: "a@example.com"}, {Role: "READER", GroupByEmail: "team@example.com"}},
+		},
+	}
+	for _, test := range tests {
+		got := getBigQueryDatasetPublicRoles(test.access)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("getBigQueryDatasetPublicRoles(%s) = %v, want %v", test.name, got, test.want)
+		}
+		if isPublic := iamPublicRolesArePublic(got); isPublic != test.isPublic {
+			t.Errorf("iamPublicRolesArePublic(%s) = %t, want %t", test.name, isPublic, test.isPublic)
+		}
+	}
+}
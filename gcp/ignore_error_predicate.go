@@ -2,10 +2,12 @@ package gcp
 
 import (
 	"context"
+	"errors"
 	"path"
 	"regexp"
 	"slices"
 
+	"github.com/googleapis/gax-go/v2/apierror"
 	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"google.golang.org/api/googleapi"
@@ -21,28 +23,35 @@ func isIgnorableError(notFoundErrors []string) plugin.ErrorPredicate {
 	}
 }
 
-// shouldIgnoreErrorPluginDefault:: Plugin level default function to ignore a set errors for hydrate functions based on "ignore_error_codes" and "ignore_error_messages" config argument
+// shouldIgnoreErrorPluginDefault:: Plugin level default function to ignore a set errors for hydrate functions based on "ignore_error_codes", "ignore_error_reasons" and "ignore_error_messages" config argument
 func shouldIgnoreErrorPluginDefault() plugin.ErrorPredicateWithContext {
 	return func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, err error) bool {
 		gcpConfig := GetConfig(d.Connection)
 
 		logger := plugin.Logger(ctx)
 
-		// Add to support regex match as per error message
-		for _, pattern := range gcpConfig.IgnoreErrorMessages {
-			// Validate regex pattern
-			re, er := regexp.Compile(pattern)
-			if er != nil {
-				panic(er.Error() + " the regex pattern configured in 'ignore_error_messages' is invalid. Edit your connection configuration file and then restart Steampipe")
+		// Skip projects where the table's API is not enabled, if the connection opts in
+		if gcpConfig.IgnoreDisabledServices != nil && *gcpConfig.IgnoreDisabledServices {
+			if service, ok := disabledService(err); ok {
+				project := d.EqualsQualString(matrixKeyProject)
+				if isServiceDisabled(ctx, d, project, service) {
+					logger.Warn("ignore_error_predicate.shouldIgnoreErrorPluginDefault", "notice", "skipping project, API is not enabled", "table", d.Table.Name, "project", project, "service", service)
+					return true
+				}
 			}
-			result := re.MatchString(err.Error())
-			if result {
+		}
+
+		// Add to support regex match as per error message
+		regexps, _ := ignoreErrorMessageRegexps(d)
+		for _, re := range regexps {
+			if re.MatchString(err.Error()) {
 				logger.Debug("ignore_error_predicate.shouldIgnoreErrorPluginDefault", "ignore_error_message", err.Error())
 				return true
 			}
 		}
 
-		if gerr, ok := err.(*googleapi.Error); ok {
+		var gerr *googleapi.Error
+		if errors.As(err, &gerr) {
 			// Added to support regex in not found errors
 			for _, pattern := range gcpConfig.IgnoreErrorCodes {
 				if ok, _ := path.Match(pattern, types.ToString(gerr.Code)); ok {
@@ -51,6 +60,115 @@ func shouldIgnoreErrorPluginDefault() plugin.ErrorPredicateWithContext {
 				}
 			}
 		}
+
+		// Match the ErrorInfo reasons of both REST and gRPC errors
+		for _, reason := range errorReasons(err) {
+			for _, pattern := range gcpConfig.IgnoreErrorReasons {
+				if ok, _ := path.Match(pattern, reason); ok {
+					logger.Debug("ignore_error_predicate.shouldIgnoreErrorPluginDefault", "ignore_error_reason", reason, "error", err.Error())
+					return true
+				}
+			}
+		}
 		return false
 	}
 }
+
+type ignoreErrorMessagePatterns struct {
+	regexps []*regexp.Regexp
+	err     error
+}
+
+// ignoreErrorMessageRegexps returns the compiled "ignore_error_messages" patterns. They are
+// compiled and validated once per connection and cached along with the first invalid pattern's
+// error, which the service constructors return. The predicate cannot fail, so it matches the
+// valid patterns only.
+func ignoreErrorMessageRegexps(d *plugin.QueryData) ([]*regexp.Regexp, error) {
+	cacheKey := "IgnoreErrorMessageRegexps"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		patterns := cachedData.(*ignoreErrorMessagePatterns)
+		return patterns.regexps, patterns.err
+	}
+
+	gcpConfig := GetConfig(d.Connection)
+	patterns := &ignoreErrorMessagePatterns{regexps: make([]*regexp.Regexp, 0, len(gcpConfig.IgnoreErrorMessages))}
+	for _, pattern := range gcpConfig.IgnoreErrorMessages {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			if patterns.err == nil {
+				patterns.err = sessionConfigError(d.Connection, "ignore_error_messages", err)
+			}
+			continue
+		}
+		patterns.regexps = append(patterns.regexps, re)
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, patterns)
+	return patterns.regexps, patterns.err
+}
+
+// errorReasons returns the reasons attached to an error, i.e. the ErrorInfo reason
+// of googleapi.Error details or gRPC status details (e.g. "IAM_PERMISSION_DENIED"),
+// plus the legacy reasons of googleapi.Error items (e.g. "accessNotConfigured")
+func errorReasons(err error) []string {
+	var reasons []string
+	if apiErr, ok := apierror.FromError(err); ok && apiErr.Reason() != "" {
+		reasons = append(reasons, apiErr.Reason())
+	}
+
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) {
+		for _, item := range gerr.Errors {
+			if item.Reason != "" && !slices.Contains(reasons, item.Reason) {
+				reasons = append(reasons, item.Reason)
+			}
+		}
+	}
+	return reasons
+}
+
+// disabledService reports whether an error was caused by an API that is not enabled
+// in the project, i.e. a SERVICE_DISABLED error reason (REST and gRPC clients) or the
+// legacy accessNotConfigured reason. It also returns the name of the disabled service
+// (e.g. "redis.googleapis.com") as listed by gcp_project_service, if known.
+func disabledService(err error) (string, bool) {
+	reasons := errorReasons(err)
+	if !slices.Contains(reasons, "SERVICE_DISABLED") && !slices.Contains(reasons, "accessNotConfigured") {
+		return "", false
+	}
+
+	service := ""
+	if apiErr, ok := apierror.FromError(err); ok {
+		service = apiErr.Metadata()["service"]
+	}
+	return service, true
+}
+
+// isServiceDisabled confirms a disabled service error against the Service Usage API, the
+// source of gcp_project_service, so that errors of a service that is enabled (e.g. while
+// the enablement propagates) are still returned. The state is cached per project and
+// service. If the service or project is unknown, or Service Usage cannot be read, the
+// error reason alone is trusted.
+func isServiceDisabled(ctx context.Context, d *plugin.QueryData, project string, service string) bool {
+	if project == "" || service == "" {
+		return true
+	}
+
+	cacheKey := "ServiceState/" + project + "/" + service
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(string) != "ENABLED"
+	}
+
+	svc, err := ServiceUsageService(ctx, d)
+	if err != nil {
+		return true
+	}
+	resp, err := svc.Services.Get("projects/" + project + "/services/" + service).Context(ctx).Do()
+	if err != nil {
+		plugin.Logger(ctx).Debug("ignore_error_predicate.isServiceDisabled", "project", project, "service", service, "error", err)
+		return true
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, resp.State)
+	return resp.State != "ENABLED"
+}
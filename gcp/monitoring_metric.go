@@ -2,6 +2,9 @@ package gcp
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -18,6 +21,14 @@ func monitoringMetricColumns(columns []*plugin.Column) []*plugin.Column {
 	return append(columns, commonMonitoringMetricColumns()...)
 }
 
+// monitoringMetricKeyColumns adds the key columns narrowing the time series interval and period
+func monitoringMetricKeyColumns(keyColumns plugin.KeyColumnSlice) plugin.KeyColumnSlice {
+	return append(keyColumns,
+		&plugin.KeyColumn{Name: "timestamp", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<="}},
+		&plugin.KeyColumn{Name: "period", Require: plugin.Optional, Operators: []string{"="}},
+	)
+}
+
 func commonMonitoringMetricColumns() []*plugin.Column {
 	return []*plugin.Column{
 		{
@@ -56,12 +67,32 @@ func commonMonitoringMetricColumns() []*plugin.Column {
 			Description: "The sum of the metric values for the data point.",
 			Type:        proto.ColumnType_DOUBLE,
 		},
+		{
+			Name:        "p50",
+			Description: "The 50th percentile of the metric values for the data point. For distribution metrics it is estimated from the merged distribution buckets.",
+			Type:        proto.ColumnType_DOUBLE,
+		},
+		{
+			Name:        "p95",
+			Description: "The 95th percentile of the metric values for the data point. For distribution metrics it is estimated from the merged distribution buckets.",
+			Type:        proto.ColumnType_DOUBLE,
+		},
+		{
+			Name:        "p99",
+			Description: "The 99th percentile of the metric values for the data point. For distribution metrics it is estimated from the merged distribution buckets.",
+			Type:        proto.ColumnType_DOUBLE,
+		},
 		{
 			Name:        "timestamp",
 			Description: "The time stamp used for the data point.",
 			Type:        proto.ColumnType_TIMESTAMP,
 			Transform:   transform.FromField("TimeStamp"),
 		},
+		{
+			Name:        "period",
+			Description: "The length of the data point interval in seconds.",
+			Type:        proto.ColumnType_INT,
+		},
 		{
 			Name:        "unit",
 			Description: "The data points of this time series. When listing time series, points are returned in reverse time order.When creating a time series, this field must contain exactly one point and the point's type must be the same as the value type of the associated metric. If the associated metric's descriptor must be auto-created, then the value type of the descriptor is determined by the point's type, which must be BOOL, INT64, DOUBLE, or DISTRIBUTION.",
@@ -131,9 +162,17 @@ type monitorMetric struct {
 	// The sum of the metric values for the data point.
 	Sum *float64
 
+	// The percentiles of the metric values for the data point.
+	P50 *float64
+	P95 *float64
+	P99 *float64
+
 	// The time stamp used for the data point.
 	TimeStamp *string
 
+	// The length of the data point interval in seconds.
+	Period int64
+
 	// The associated monitored resource.
 	Resource *monitoring.MonitoredResource
 
@@ -173,15 +212,40 @@ func getMonitoringPeriodForGranularity(granularity string) string {
 	return "300s"
 }
 
-func getIncrementalTimeAsPerGranularity(granularity string) time.Duration {
-	switch granularity {
-	case "DAILY":
-		return 86400
-	case "HOURLY":
-		return 3600
-	default:
-		return 300
+// getMonitoringIntervalFromQuals returns the interval to list time series for, narrowed by any
+// `timestamp` quals. Without a lower bound the interval starts at defaultStart, and without an
+// upper bound it ends now.
+func getMonitoringIntervalFromQuals(quals plugin.KeyColumnQualMap, defaultStart time.Time) (time.Time, time.Time) {
+	startTime, endTime := defaultStart, time.Now()
+
+	if quals["timestamp"] != nil {
+		for _, q := range quals["timestamp"].Quals {
+			if q.Value.GetTimestampValue() == nil {
+				continue
+			}
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case "=":
+				startTime, endTime = timestamp, timestamp
+			case ">", ">=":
+				startTime = timestamp
+			case "<", "<=":
+				endTime = timestamp
+			}
+		}
 	}
+
+	return startTime, endTime
+}
+
+// getMonitoringPeriod returns the data point interval in seconds, which is the `period`
+// qual if given or else the table's granularity
+func getMonitoringPeriod(d *plugin.QueryData, granularity string) int64 {
+	if d.EqualsQuals["period"] != nil {
+		return d.EqualsQuals["period"].GetInt64Value()
+	}
+	period, _ := strconv.ParseInt(strings.TrimSuffix(getMonitoringPeriodForGranularity(granularity), "s"), 10, 64)
+	return period
 }
 
 func listMonitorMetricStatistics(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, granularity string, metricType string, dimensionKey string, dimensionValue string, resourceName string, location string) (*monitoring.ListTimeSeriesResponse, error) {
@@ -201,44 +265,55 @@ func listMonitorMetricStatistics(ctx context.Context, d *plugin.QueryData, h *pl
 	}
 	project := projectId.(string)
 
-	endTime := time.Now().Format(time.RFC3339)
-	startTime := getMonitoringStartDateForGranularity(granularity).Format(time.RFC3339)
-	period := getMonitoringPeriodForGranularity(granularity)
+	// `timestamp` quals narrow the default interval of the granularity
+	startTime, endTime := getMonitoringIntervalFromQuals(d.Quals, getMonitoringStartDateForGranularity(granularity))
+	period := getMonitoringPeriod(d, granularity)
+	if period <= 0 {
+		return nil, fmt.Errorf("period must be a positive number of seconds, got %d", period)
+	}
 
 	filterString := "metric.type = " + metricType + " AND " + dimensionKey + dimensionValue
 
-	resp := service.Projects.TimeSeries.List("projects/" + project).Filter(filterString).IntervalStartTime(startTime).IntervalEndTime(endTime).AggregationAlignmentPeriod(period)
+	resp := service.Projects.TimeSeries.List("projects/" + project).Filter(filterString).IntervalStartTime(startTime.Format(time.RFC3339)).IntervalEndTime(endTime.Format(time.RFC3339)).AggregationAlignmentPeriod(fmt.Sprintf("%ds", period))
+
+	var timeSeries []*monitoring.TimeSeries
 	if err := resp.Pages(ctx, func(page *monitoring.ListTimeSeriesResponse) error {
 		// apply rate limiting
 		d.WaitForListRateLimit(ctx)
 
-		for _, metric := range page.TimeSeries {
-			statistics, _ := metricstatistic(granularity, metric.Points, ctx)
-			for _, statistic := range statistics {
-				d.StreamLeafListItem(ctx, &monitorMetric{
-					DimensionValue: strings.ReplaceAll(dimensionValue, "\"", ""),
-					Metadata:       metric.Metadata,
-					Metric:         metric.Metric,
-					MetricKind:     metric.MetricKind,
-					Points:         metric.Points,
-					Maximum:        &statistic.Maximum,
-					Minimum:        &statistic.Minimum,
-					Average:        &statistic.Average,
-					SampleCount:    &statistic.SampleCount,
-					Sum:            &statistic.Sum,
-					TimeStamp:      &statistic.TimeStamp,
-					Resource:       metric.Resource,
-					Unit:           metric.Unit,
-					Location:       location,
-					Project:        project,
-				})
-			}
-		}
+		timeSeries = append(timeSeries, page.TimeSeries...)
 		return nil
 	}); err != nil {
 		return nil, err
 	}
 
+	for _, metric := range timeSeries {
+		statistics, _ := metricstatistic(period, metric.Points, ctx)
+		for _, statistic := range statistics {
+			d.StreamLeafListItem(ctx, &monitorMetric{
+				DimensionValue: strings.ReplaceAll(dimensionValue, "\"", ""),
+				Metadata:       metric.Metadata,
+				Metric:         metric.Metric,
+				MetricKind:     metric.MetricKind,
+				Points:         metric.Points,
+				Maximum:        &statistic.Maximum,
+				Minimum:        &statistic.Minimum,
+				Average:        &statistic.Average,
+				SampleCount:    &statistic.SampleCount,
+				Sum:            &statistic.Sum,
+				P50:            &statistic.P50,
+				P95:            &statistic.P95,
+				P99:            &statistic.P99,
+				TimeStamp:      &statistic.TimeStamp,
+				Period:         period,
+				Resource:       metric.Resource,
+				Unit:           metric.Unit,
+				Location:       location,
+				Project:        project,
+			})
+		}
+	}
+
 	return nil, nil
 }
 
@@ -248,6 +323,9 @@ type PointWithTimeStamp struct {
 
 	// Time stamp of the point value
 	TimeStamp string
+
+	// The distribution of a DISTRIBUTION point, whose mean is the Point
+	Distribution *monitoring.Distribution
 }
 
 type Statistics struct {
@@ -256,11 +334,14 @@ type Statistics struct {
 	Sum         float64
 	Average     float64
 	SampleCount float64
+	P50         float64
+	P95         float64
+	P99         float64
 	TimeStamp   string
 }
 
 // Get metric statistic
-func metricstatistic(granularity string, points []*monitoring.Point, ctx context.Context) ([]*Statistics, error) {
+func metricstatistic(period int64, points []*monitoring.Point, ctx context.Context) ([]*Statistics, error) {
 	var pointValues []*PointWithTimeStamp
 	var statistics []*Statistics
 
@@ -269,7 +350,7 @@ func metricstatistic(granularity string, points []*monitoring.Point, ctx context
 		pointValueType := value.Value
 		timeStamp := value.Interval.StartTime
 
-		// TODO: Need to handle BoolType, StringValue and DistributionValue
+		// TODO: Need to handle BoolType
 		if pointValueType.DoubleValue != nil {
 			pointValues = append(pointValues, &PointWithTimeStamp{Point: *pointValueType.DoubleValue, TimeStamp: timeStamp})
 		}
@@ -279,6 +360,11 @@ func metricstatistic(granularity string, points []*monitoring.Point, ctx context
 			pointValues = append(pointValues, &PointWithTimeStamp{Point: val, TimeStamp: timeStamp})
 		}
 
+		// A distribution (e.g. of request latencies) contributes its mean, and its buckets to the percentiles
+		if pointValueType.DistributionValue != nil && pointValueType.DistributionValue.Count > 0 {
+			pointValues = append(pointValues, &PointWithTimeStamp{Point: pointValueType.DistributionValue.Mean, TimeStamp: timeStamp, Distribution: pointValueType.DistributionValue})
+		}
+
 		if pointValueType.StringValue != nil {
 			val, err := strconv.ParseFloat(*pointValueType.StringValue, 64)
 			if err != nil {
@@ -288,8 +374,15 @@ func metricstatistic(granularity string, points []*monitoring.Point, ctx context
 		}
 	}
 
+	// Only BOOL points, or empty distributions, if any
+	if len(pointValues) == 0 {
+		return nil, nil
+	}
+
 	// Initialize max and min value with first point value
 	var sum, average, sampleCount float64
+	var values []float64
+	var distributions []*monitoring.Distribution
 	minValue := pointValues[0].Point
 	maxValue := minValue
 
@@ -304,27 +397,30 @@ func metricstatistic(granularity string, points []*monitoring.Point, ctx context
 		plugin.Logger(ctx).Trace("Time Diff", timeDiff)
 
 		// Check time duration between start time and current point time stamp
-		interval, _ := strconv.ParseFloat(strings.ReplaceAll(getMonitoringPeriodForGranularity(granularity), "s", ""), 64)
 		diffCheckExecuted = false
 
-		// Check time diff(DAILY, HOURLY) and push the details to statistics
-		if timeDiff >= interval {
+		// Check time diff against the period and push the details to statistics
+		if timeDiff >= float64(period) {
 			sampleCount = float64(pointCount)
 			average = sum / sampleCount
+			p50, p95, p99 := periodPercentiles(values, distributions)
 			statistics = append(statistics, &Statistics{
 				Maximum:     maxValue,
 				Minimum:     minValue,
 				Sum:         sum,
 				Average:     average,
 				SampleCount: sampleCount,
+				P50:         p50,
+				P95:         p95,
+				P99:         p99,
 				TimeStamp:   startTime,
 			})
 			maxValue, minValue = pointValues[pointCount].Point, pointValues[pointCount].Point
-			pointCount, sum, diffCheckExecuted = 0, 0.0, true
+			pointCount, sum, values, distributions, diffCheckExecuted = 0, 0.0, nil, nil, true
 
-			// Set the time interval as per granularity
+			// Move the time interval back by one period
 			currentStartTime, _ := time.Parse(time.RFC3339, startTime)
-			startTime = currentStartTime.Add(-time.Second * getIncrementalTimeAsPerGranularity(granularity)).Format(time.RFC3339)
+			startTime = currentStartTime.Add(-time.Second * time.Duration(period)).Format(time.RFC3339)
 		}
 
 		if point.Point > maxValue {
@@ -335,6 +431,10 @@ func metricstatistic(granularity string, points []*monitoring.Point, ctx context
 		}
 
 		sum += point.Point
+		values = append(values, point.Point)
+		if point.Distribution != nil {
+			distributions = append(distributions, point.Distribution)
+		}
 		pointCount++
 		pointIndex++
 	}
@@ -343,12 +443,16 @@ func metricstatistic(granularity string, points []*monitoring.Point, ctx context
 	if pointIndex == int64(len(pointValues)) && !diffCheckExecuted {
 		sampleCount = float64(pointCount)
 		average = sum / sampleCount
+		p50, p95, p99 := periodPercentiles(values, distributions)
 		statistics = append(statistics, &Statistics{
 			Maximum:     maxValue,
 			Minimum:     minValue,
 			Sum:         sum,
 			Average:     average,
 			SampleCount: sampleCount,
+			P50:         p50,
+			P95:         p95,
+			P99:         p99,
 			TimeStamp:   startTime,
 		})
 	}
@@ -356,6 +460,126 @@ func metricstatistic(granularity string, points []*monitoring.Point, ctx context
 	return statistics, nil
 }
 
+// periodPercentiles returns the 50th, 95th and 99th percentiles of a period. If its points are
+// distributions with the same buckets, they are the percentiles of the merged distribution, e.g. of
+// all the request latencies rather than of the mean latency of each point. Otherwise they are the
+// percentiles of the point values.
+func periodPercentiles(values []float64, distributions []*monitoring.Distribution) (float64, float64, float64) {
+	if len(distributions) > 0 && len(distributions) == len(values) {
+		if histogram, ok := mergeDistributions(distributions); ok {
+			return histogram.percentile(50), histogram.percentile(95), histogram.percentile(99)
+		}
+	}
+	return percentile(values, 50), percentile(values, 95), percentile(values, 99)
+}
+
+// distributionHistogram is the merged bucket counts of distributions with the same buckets. The
+// bounds are the edges of the finite buckets: bucket 0 is the underflow bucket below bounds[0],
+// bucket i is [bounds[i-1], bounds[i]), and the last bucket is the overflow bucket.
+type distributionHistogram struct {
+	bounds []float64
+	counts []int64
+	total  int64
+	// the range of the values, if every distribution reported one
+	hasRange bool
+	min, max float64
+}
+
+// mergeDistributions merges the bucket counts of distributions, or returns false if they have no
+// buckets or different buckets
+func mergeDistributions(distributions []*monitoring.Distribution) (*distributionHistogram, bool) {
+	histogram := &distributionHistogram{}
+	for i, distribution := range distributions {
+		bounds, ok := distributionBucketBounds(distribution.BucketOptions)
+		if !ok || len(distribution.BucketCounts) > len(bounds)+1 {
+			return nil, false
+		}
+		if i == 0 {
+			histogram.bounds = bounds
+			histogram.counts = make([]int64, len(bounds)+1)
+			histogram.hasRange = distribution.Range != nil
+			if histogram.hasRange {
+				histogram.min, histogram.max = distribution.Range.Min, distribution.Range.Max
+			}
+		} else if !slices.Equal(histogram.bounds, bounds) {
+			return nil, false
+		}
+
+		for bucket, count := range distribution.BucketCounts {
+			histogram.counts[bucket] += count
+			histogram.total += count
+		}
+		if distribution.Range == nil {
+			histogram.hasRange = false
+		} else if histogram.hasRange {
+			histogram.min, histogram.max = min(histogram.min, distribution.Range.Min), max(histogram.max, distribution.Range.Max)
+		}
+	}
+	return histogram, histogram.total > 0
+}
+
+// distributionBucketBounds returns the edges of the finite buckets of a distribution
+func distributionBucketBounds(options *monitoring.BucketOptions) ([]float64, bool) {
+	var bounds []float64
+	switch {
+	case options == nil:
+		return nil, false
+	case options.LinearBuckets != nil:
+		for i := int64(0); i <= options.LinearBuckets.NumFiniteBuckets; i++ {
+			bounds = append(bounds, options.LinearBuckets.Offset+options.LinearBuckets.Width*float64(i))
+		}
+	case options.ExponentialBuckets != nil:
+		for i := int64(0); i <= options.ExponentialBuckets.NumFiniteBuckets; i++ {
+			bounds = append(bounds, options.ExponentialBuckets.Scale*math.Pow(options.ExponentialBuckets.GrowthFactor, float64(i)))
+		}
+	case options.ExplicitBuckets != nil:
+		bounds = options.ExplicitBuckets.Bounds
+	}
+	return bounds, len(bounds) > 0
+}
+
+// percentile returns the p-th percentile of the histogram, interpolating linearly within the bucket
+// it falls in. The underflow and overflow buckets are bounded by the range of the values if known,
+// or else by their finite edge.
+func (h *distributionHistogram) percentile(p float64) float64 {
+	rank := p / 100 * float64(h.total)
+	var cumulative int64
+	for bucket, count := range h.counts {
+		if count == 0 || float64(cumulative+count) < rank {
+			cumulative += count
+			continue
+		}
+
+		lower, upper := h.bounds[max(bucket-1, 0)], h.bounds[min(bucket, len(h.bounds)-1)]
+		if h.hasRange {
+			lower, upper = max(lower, h.min), min(upper, h.max)
+			if bucket == 0 {
+				lower = h.min
+			}
+			if bucket == len(h.bounds) {
+				upper = h.max
+			}
+		}
+		return lower + (upper-lower)*(rank-float64(cumulative))/float64(count)
+	}
+	return h.bounds[len(h.bounds)-1]
+}
+
+// percentile returns the p-th percentile of the point values of a period, interpolating linearly
+// between the closest ranks
+func percentile(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
 // Check time difference in second
 func checkTimeDiff(startTime string, endTime string) float64 {
 	dt1, err := time.Parse(time.RFC3339, startTime)
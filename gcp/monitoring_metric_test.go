@@ -0,0 +1,96 @@
+package gcp
+
+import (
+	"testing"
+
+	"google.golang.org/api/monitoring/v3"
+)
+
+func TestPercentile(t *testing.T) {
+	tests := []struct {
+		values []float64
+		p      float64
+		want   float64
+	}{
+		{nil, 50, 0},
+		{[]float64{7}, 99, 7},
+		{[]float64{5, 1, 4, 2, 3}, 50, 3},
+		{[]float64{1, 2, 3, 4}, 50, 2.5},
+		{[]float64{10, 20, 30, 40, 50, 60, 70, 80, 90, 100}, 95, 95.5},
+		{[]float64{10, 20, 30, 40, 50, 60, 70, 80, 90, 100}, 99, 99.1},
+	}
+	for _, test := range tests {
+		if got := percentile(test.values, test.p); got < test.want-1e-9 || got > test.want+1e-9 {
+			t.Errorf("percentile(%v, %v) = %v, want %v", test.values, test.p, got, test.want)
+		}
+	}
+}
+
+func TestPeriodPercentiles(t *testing.T) {
+	explicit := &monitoring.BucketOptions{ExplicitBuckets: &monitoring.Explicit{Bounds: []float64{0, 10, 20, 30}}}
+	tests := []struct {
+		name          string
+		values        []float64
+		distributions []*monitoring.Distribution
+		want          [3]float64
+	}{
+		{
+			name:   "point values",
+			values: []float64{10, 20, 30, 40, 50, 60, 70, 80, 90, 100},
+			want:   [3]float64{55, 95.5, 99.1},
+		},
+		{
+			// the trailing empty buckets are omitted by the API
+			name:   "merged distributions",
+			values: []float64{5, 15},
+			distributions: []*monitoring.Distribution{
+				{Count: 5, BucketOptions: explicit, BucketCounts: []int64{0, 5}},
+				{Count: 5, BucketOptions: explicit, BucketCounts: []int64{0, 0, 5}},
+			},
+			want: [3]float64{10, 19, 19.8},
+		},
+		{
+			name:   "range bounds the buckets",
+			values: []float64{5, 15},
+			distributions: []*monitoring.Distribution{
+				{Count: 5, BucketOptions: explicit, BucketCounts: []int64{0, 5}, Range: &monitoring.Range{Min: 2, Max: 9}},
+				{Count: 5, BucketOptions: explicit, BucketCounts: []int64{0, 0, 5}, Range: &monitoring.Range{Min: 12, Max: 18}},
+			},
+			want: [3]float64{10, 17.2, 17.84},
+		},
+		{
+			name:   "overflow bucket",
+			values: []float64{30},
+			distributions: []*monitoring.Distribution{
+				{Count: 4, BucketOptions: &monitoring.BucketOptions{LinearBuckets: &monitoring.Linear{NumFiniteBuckets: 2, Width: 5}}, BucketCounts: []int64{0, 0, 0, 4}, Range: &monitoring.Range{Min: 12, Max: 50}},
+			},
+			want: [3]float64{31, 48.1, 49.62},
+		},
+		{
+			name:   "exponential buckets",
+			values: []float64{2},
+			distributions: []*monitoring.Distribution{
+				{Count: 2, BucketOptions: &monitoring.BucketOptions{ExponentialBuckets: &monitoring.Exponential{NumFiniteBuckets: 2, GrowthFactor: 2, Scale: 1}}, BucketCounts: []int64{0, 1, 1}},
+			},
+			want: [3]float64{2, 3.8, 3.96},
+		},
+		{
+			name:   "different buckets fall back to the means",
+			values: []float64{5, 15},
+			distributions: []*monitoring.Distribution{
+				{Count: 5, BucketOptions: explicit, BucketCounts: []int64{0, 5}},
+				{Count: 5, BucketOptions: &monitoring.BucketOptions{ExplicitBuckets: &monitoring.Explicit{Bounds: []float64{0, 100}}}, BucketCounts: []int64{0, 5}},
+			},
+			want: [3]float64{10, 14.5, 14.9},
+		},
+	}
+	for _, test := range tests {
+		p50, p95, p99 := periodPercentiles(test.values, test.distributions)
+		for i, got := range []float64{p50, p95, p99} {
+			if got < test.want[i]-1e-9 || got > test.want[i]+1e-9 {
+				t.Errorf("periodPercentiles(%s) = %v, %v, %v, want %v", test.name, p50, p95, p99, test.want)
+				break
+			}
+		}
+	}
+}
@@ -28,6 +28,11 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 		DefaultIgnoreConfig: &plugin.IgnoreConfig{
 			ShouldIgnoreErrorFunc: shouldIgnoreErrorPluginDefault(),
 		},
+		// Default retry config for the plugin. API errors are retried by the API clients, see
+		// errorRetryPolicy, so it only retries requests that failed with a network error.
+		DefaultRetryConfig: &plugin.RetryConfig{
+			ShouldRetryErrorFunc: shouldRetryErrorPluginDefault(),
+		},
 		RateLimiters: []*rate_limiter.Definition{
 			// API Requests per 100 seconds: 5,000
 			// https://cloud.google.com/memorystore/docs/redis/quotas#per-second_api_requests_quota
@@ -36,14 +41,14 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 				FillRate:   50,
 				BucketSize: 5000,
 				Scope:      []string{"connection", "service", "action"},
-				Where:      "service = 'redis' and action = 'ListInstances'",
+				Where:      "service = 'redis' and action = 'instances.list'",
 			},
 			{
 				Name:       "gcp_redis_get_instance",
 				FillRate:   50,
 				BucketSize: 5000,
 				Scope:      []string{"connection", "service", "action"},
-				Where:      "service = 'redis' and action = 'GetInstance'",
+				Where:      "service = 'redis' and action = 'instances.get'",
 			},
 			// Redis Cluster requests per project per minute: 60
 			// https://cloud.google.com/memorystore/docs/cluster/quotas#per-minute_api_requests_quota
@@ -64,17 +69,18 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 
 			// Cloud Resource Manager & Service Usage API rate quota: 1,200 requests/minute per user
 			// Doc: https://cloud.google.com/resource-manager/quotas (see API rate quotas) and https://cloud.google.com/service-usage/quotas
-			// Tables: gcp_project, gcp_organization, gcp_organization_project, gcp_project_organization_policy, gcp_project_service, gcp_iam_policy
+			// Tables: gcp_project, gcp_organization, gcp_organization_project, gcp_project_organization_policy, gcp_project_service, gcp_iam_policy, gcp_iam_effective_binding, gcp_tag_binding
+			// Scoped by quota_project, so connections sharing a quota project share the bucket
 			{
 				Name:       "gcp_resourcemanager",
 				FillRate:   20,
 				BucketSize: 1200,
-				Scope:      []string{"connection", "service", "action"},
-				Where:      "service in ('resourcemanager', 'serviceusage') and action in ('organizations.get', 'projects.list', 'projects.getIamPolicy', 'services.list', 'services.get')",
+				Scope:      []string{"quota_project", "service", "action"},
+				Where:      "service in ('resourcemanager', 'serviceusage') and action in ('organizations.get', 'organizations.getIamPolicy', 'projects.list', 'projects.getIamPolicy', 'hierarchyNodes.listTagBindings', 'services.list', 'services.get')",
 			},
 
 			// Cloud Resource Manager API rate quota: 600 read requests per minute per project (10 per second)
-			// FIXME: Limits are per API consumer project so we need to find a way to take quota_project into account instead of connection
+			// Limits are per API consumer project, so these limiters are scoped by quota_project instead of connection
 			// Doc: https://cloud.google.com/resource-manager/docs/limits
 			// APIs: projects.getAccessApprovalSettings, projects.getAncestry
 			// Tables: gcp_project, gcp_organization_project
@@ -83,27 +89,27 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 				Name:       "gcp_cloudresourcemanager_projects_get_access_approval_settings",
 				FillRate:   10,
 				BucketSize: 60,
-				Scope:      []string{"connection", "service", "action"},
+				Scope:      []string{"quota_project", "service", "action"},
 				Where:      "service = 'resourcemanager' and action = 'projects.getAccessApprovalSettings'",
 			},
 			{
 				Name:       "gcp_cloudresourcemanager_projects_get_ancestry",
 				FillRate:   10,
 				BucketSize: 60,
-				Scope:      []string{"connection", "service", "action"},
+				Scope:      []string{"quota_project", "service", "action"},
 				Where:      "service = 'resourcemanager' and action = 'projects.getAncestry'",
 			},
 
 			// Compute Engine API rate quotas are enforced per minute and vary by project and method group.
-			// This limiter targets a conservative 10 rps with a burst of 20 for common read/list methods.
+			// This limiter targets a conservative 10 rps with a burst of 20 for all read/list methods.
 			// Doc: https://cloud.google.com/compute/api-quota and metrics: https://cloud.google.com/compute/docs/api/compute-api-quota-metrics
-			// Tables: gcp_compute_instance, gcp_compute_disk, gcp_compute_image, gcp_compute_snapshot, gcp_compute_address, gcp_compute_global_address, gcp_compute_network, gcp_compute_subnetwork, gcp_compute_firewall, gcp_compute_route, gcp_compute_ssl_policy, gcp_compute_url_map, gcp_compute_forwarding_rule, gcp_compute_global_forwarding_rule
+			// Tables: all gcp_compute_* tables
 			{
 				Name:       "gcp_compute",
 				FillRate:   10,
 				BucketSize: 20,
 				Scope:      []string{"connection", "service", "action"},
-				Where:      "service = 'compute' and action in ('instances.get', 'instances.list', 'instances.getIamPolicy', 'disks.get', 'disks.list', 'disks.getIamPolicy', 'images.list', 'images.get', 'images.getIamPolicy', 'snapshots.list', 'snapshots.get', 'addresses.list', 'addresses.get', 'globalAddresses.list', 'globalAddresses.get', 'networks.list', 'networks.get', 'subnetworks.list', 'subnetworks.get', 'subnetworks.getIamPolicy', 'firewalls.list', 'firewalls.get', 'sslPolicies.list', 'sslPolicies.get', 'urlMaps.list', 'urlMaps.get', 'forwardingRules.list', 'forwardingRules.get', 'globalForwardingRules.list', 'globalForwardingRules.get')",
+				Where:      "service = 'compute'",
 			},
 
 			// Approximately 5000 read requests per second: https://cloud.google.com/storage/docs/request-rate#auto-scaling
@@ -182,146 +188,401 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 				Scope:      []string{"connection", "service", "action", "location"},
 				Where:      "service = 'dns' and action in ('managedZones.list', 'managedZones.get', 'policies.list', 'policies.get', 'resourceRecordSets.list', 'resourceRecordSets.get')",
 			},
-		},
-		ConnectionKeyColumns: []plugin.ConnectionKeyColumn{
+
+			// Vertex AI resource management (CRUD) requests per minute per region: 600
+			// Doc: https://cloud.google.com/vertex-ai/docs/quotas#request_quotas
+			// Tables: gcp_vertex_ai_endpoint, gcp_vertex_ai_model, gcp_vertex_ai_notebook_runtime_template
+			{
+				Name:       "gcp_aiplatform",
+				FillRate:   10,
+				BucketSize: 600,
+				Scope:      []string{"connection", "service"},
+				Where:      "service = 'aiplatform'",
+			},
+
+			// AlloyDB Admin API read requests per minute per project per region: 600
+			// Doc: https://cloud.google.com/alloydb/quotas
+			// Tables: gcp_alloydb_cluster, gcp_alloydb_instance
+			{
+				Name:       "gcp_alloydb",
+				FillRate:   10,
+				BucketSize: 600,
+				Scope:      []string{"connection", "service"},
+				Where:      "service = 'alloydb'",
+			},
+
+			// API Keys API read requests per minute per project: 300
+			// Doc: https://cloud.google.com/api-keys/docs/quotas
+			// Tables: gcp_apikeys_key
+			{
+				Name:       "gcp_apikeys",
+				FillRate:   5,
+				BucketSize: 300,
+				Scope:      []string{"connection", "service"},
+				Where:      "service = 'apikeys'",
+			},
+
+			// App Engine Admin API requests per minute per project: 600
+			// Doc: https://cloud.google.com/appengine/docs/standard/quotas#Requests
+			// Tables: gcp_app_engine_application
+			{
+				Name:       "gcp_appengine",
+				FillRate:   10,
+				BucketSize: 600,
+				Scope:      []string{"connection", "service"},
+				Where:      "service = 'appengine'",
+			},
+
+			// Artifact Registry requests per minute per project per region: 60,000
+			// Doc: https://cloud.google.com/artifact-registry/quotas
+			// Tables: gcp_artifact_registry_repository
 			{
-				Name:    "project",
-				Hydrate: getProject,
+				Name:       "gcp_artifactregistry",
+				FillRate:   1000,
+				BucketSize: 60000,
+				Scope:      []string{"connection", "service"},
+				Where:      "service = 'artifactregistry'",
+			},
+
+			// BigQuery API requests per second per user per method: 100
+			// Doc: https://cloud.google.com/bigquery/quotas#api_request_quotas
+			// Tables: gcp_bigquery_dataset, gcp_bigquery_table, gcp_bigquery_job
+			{
+				Name:       "gcp_bigquery",
+				FillRate:   100,
+				BucketSize: 100,
+				Scope:      []string{"connection", "service", "action"},
+				Where:      "service = 'bigquery'",
+			},
+
+			// Bigtable Admin API instance read requests per minute per project: 1,000
+			// Doc: https://cloud.google.com/bigtable/quotas#admin-requests
+			// Tables: gcp_bigtable_instance
+			{
+				Name:       "gcp_bigtable",
+				FillRate:   16,
+				BucketSize: 1000,
+				Scope:      []string{"connection", "service"},
+				Where:      "service = 'bigtable'",
+			},
+
+			// Cloud Billing and Billing Budget API read requests per minute per user: 300
+			// Doc: https://cloud.google.com/billing/quotas
+			// Tables: gcp_billing_account, gcp_billing_budget
+			{
+				Name:       "gcp_billing",
+				FillRate:   5,
+				BucketSize: 300,
+				Scope:      []string{"quota_project", "service"},
+				Where:      "service = 'billing'",
+			},
+
+			// Cloud Asset API ListAssets requests per minute per project: 100
+			// The quota is per method, so each action has its own bucket at the ListAssets rate
+			// Doc: https://cloud.google.com/asset-inventory/docs/quota
+			// Tables: gcp_cloud_asset, gcp_cloud_asset_iam_policy_search, gcp_cloud_asset_resource_search
+			{
+				Name:       "gcp_cloudasset",
+				FillRate:   1,
+				BucketSize: 100,
+				Scope:      []string{"connection", "service", "action"},
+				Where:      "service = 'cloudasset'",
+			},
+
+			// Cloud Identity Groups API read requests per minute per project: 1,200
+			// Doc: https://cloud.google.com/identity/docs/reference/rest#quotas
+			// Tables: gcp_cloud_identity_group, gcp_cloud_identity_group_membership
+			{
+				Name:       "gcp_cloudidentity",
+				FillRate:   20,
+				BucketSize: 1200,
+				Scope:      []string{"quota_project", "service"},
+				Where:      "service = 'cloudidentity'",
+			},
+
+			// Cloud KMS read requests per minute per project: 300,000
+			// Doc: https://cloud.google.com/kms/quotas#request_quotas
+			// Tables: gcp_kms_key_ring, gcp_kms_key, gcp_kms_key_version
+			{
+				Name:       "gcp_cloudkms",
+				FillRate:   5000,
+				BucketSize: 300000,
+				Scope:      []string{"connection", "service"},
+				Where:      "service = 'cloudkms'",
+			},
+
+			// Cloud SQL Admin API requests per minute per user per region: 180
+			// Doc: https://cloud.google.com/sql/docs/quotas#admin_api_quotas
+			// Tables: gcp_sql_database_instance, gcp_sql_database, gcp_sql_backup
+			{
+				Name:       "gcp_cloudsql",
+				FillRate:   3,
+				BucketSize: 180,
+				Scope:      []string{"connection", "service"},
+				Where:      "service = 'cloudsql'",
+			},
+
+			// Kubernetes Engine API requests per minute per project per region: 3,000
+			// Doc: https://cloud.google.com/kubernetes-engine/quotas#limits_per_project
+			// Tables: gcp_kubernetes_cluster, gcp_kubernetes_node_pool
+			{
+				Name:       "gcp_container",
+				FillRate:   50,
+				BucketSize: 3000,
+				Scope:      []string{"connection", "service"},
+				Where:      "service = 'container'",
+			},
+
+			// Dataplex API read requests per minute per project per region: 1,200
+			// Doc: https://cloud.google.com/dataplex/docs/quotas
+			// Tables: gcp_dataplex_lake, gcp_dataplex_zone, gcp_dataplex_asset, gcp_dataplex_task
+			{
+				Name:       "gcp_dataplex",
+				FillRate:   20,
+				BucketSize: 1200,
+				Scope:      []string{"connection", "service"},
+				Where:      "service = 'dataplex'",
+			},
+
+			// Dataproc API get/list requests per minute per project per region: 7,500
+			// Doc: https://cloud.google.com/dataproc/quotas
+			// Tables: gcp_dataproc_cluster
+			{
+				Name:       "gcp_dataproc",
+				FillRate:   125,
+				BucketSize: 7500,
+				Scope:      []string{"connection", "service"},
+				Where:      "service = 'dataproc'",
+			},
+
+			// Cloud Monitoring API read requests per minute per project: 6,000
+			// Doc: https://cloud.google.com/monitoring/quotas#api-quotas
+			// Tables: gcp_monitoring_alert_policy, gcp_monitoring_group, gcp_monitoring_mql_query, gcp_monitoring_notification_channel, gcp_monitoring_promql_query, gcp_monitoring_time_series and the *_metric_* tables
+			{
+				Name:       "gcp_monitoring",
+				FillRate:   100,
+				BucketSize: 6000,
+				Scope:      []string{"connection", "service"},
+				Where:      "service = 'monitoring'",
+			},
+
+			// Cloud Run Admin API read requests per minute per project per region: 1,000
+			// Doc: https://cloud.google.com/run/quotas#api
+			// Tables: gcp_cloud_run_service, gcp_cloud_run_job
+			{
+				Name:       "gcp_run",
+				FillRate:   16,
+				BucketSize: 1000,
+				Scope:      []string{"connection", "service"},
+				Where:      "service = 'run'",
+			},
+
+			// Cloud TPU API read requests per minute per project: 600
+			// Doc: https://cloud.google.com/tpu/docs/quota#api_request_quota
+			// Tables: gcp_tpu_vm
+			{
+				Name:       "gcp_tpu",
+				FillRate:   10,
+				BucketSize: 600,
+				Scope:      []string{"connection", "service"},
+				Where:      "service = 'tpu'",
+			},
+
+			// Cloud Workstations API read requests per minute per project per region: 600
+			// Doc: https://cloud.google.com/workstations/quotas
+			// Tables: gcp_workstations_workstation_cluster, gcp_workstations_workstation
+			{
+				Name:       "gcp_workstations",
+				FillRate:   10,
+				BucketSize: 600,
+				Scope:      []string{"connection", "service"},
+				Where:      "service = 'workstations'",
 			},
 		},
 		ConnectionConfigSchema: &plugin.ConnectionConfigSchema{
 			NewInstance: ConfigInstance,
 		},
-		TableMap: map[string]*plugin.Table{
-			"gcp_alloydb_cluster":                                     tableGcpAlloyDBCluster(ctx),
-			"gcp_alloydb_instance":                                    tableGcpAlloyDBInstance(ctx),
-			"gcp_apikeys_key":                                         tableGcpApiKeysKey(ctx),
-			"gcp_app_engine_application":                              tableGcpAppEngineApplication(ctx),
-			"gcp_artifact_registry_repository":                        tableGcpArtifactRegistryRepository(ctx),
-			"gcp_audit_policy":                                        tableGcpAuditPolicy(ctx),
-			"gcp_organization_audit_policy":                           tableGcpOrganizationAuditPolicy(ctx),
-			"gcp_bigquery_dataset":                                    tableGcpBigQueryDataset(ctx),
-			"gcp_bigquery_job":                                        tableGcpBigQueryJob(ctx),
-			"gcp_bigquery_table":                                      tableGcpBigqueryTable(ctx),
-			"gcp_bigtable_instance":                                   tableGcpBigtableInstance(ctx),
-			"gcp_billing_account":                                     tableGcpBillingAccount(ctx),
-			"gcp_billing_budget":                                      tableGcpBillingBudget(ctx),
-			"gcp_cloud_asset":                                         tableGcpCloudAsset(ctx),
-			"gcp_cloud_identity_group":                                tableGcpCloudIdentityGroup(ctx),
-			"gcp_cloud_identity_group_membership":                     tableGcpCloudIdentityGroupMembership(ctx),
-			"gcp_cloudfunctions_function":                             tableGcpCloudfunctionFunction(ctx),
-			"gcp_cloud_run_job":                                       tableGcpCloudRunJob(ctx),
-			"gcp_cloud_run_service":                                   tableGcpCloudRunService(ctx),
-			"gcp_composer_environment":                                tableGcpComposerEnvironment(ctx),
-			"gcp_compute_address":                                     tableGcpComputeAddress(ctx),
-			"gcp_compute_autoscaler":                                  tableGcpComputeAutoscaler(ctx),
-			"gcp_compute_backend_bucket":                              tableGcpComputeBackendBucket(ctx),
-			"gcp_compute_backend_service":                             tableGcpComputeBackendService(ctx),
-			"gcp_compute_disk":                                        tableGcpComputeDisk(ctx),
-			"gcp_compute_disk_metric_read_ops":                        tableGcpComputeDiskMetricReadOps(ctx),
-			"gcp_compute_disk_metric_read_ops_daily":                  tableGcpComputeDiskMetricReadOpsDaily(ctx),
-			"gcp_compute_disk_metric_read_ops_hourly":                 tableGcpComputeDiskMetricReadOpsHourly(ctx),
-			"gcp_compute_disk_metric_write_ops":                       tableGcpComputeDiskMetricWriteOps(ctx),
-			"gcp_compute_disk_metric_write_ops_daily":                 tableGcpComputeDiskMetricWriteOpsDaily(ctx),
-			"gcp_compute_disk_metric_write_ops_hourly":                tableGcpComputeDiskMetricWriteOpsHourly(ctx),
-			"gcp_compute_firewall":                                    tableGcpComputeFirewall(ctx),
-			"gcp_compute_forwarding_rule":                             tableGcpComputeForwardingRule(ctx),
-			"gcp_compute_global_address":                              tableGcpComputeGlobalAddress(ctx),
-			"gcp_compute_global_forwarding_rule":                      tableGcpComputeGlobalForwardingRule(ctx),
-			"gcp_compute_ha_vpn_gateway":                              tableGcpComputeHaVpnGateway(ctx),
-			"gcp_compute_image":                                       tableGcpComputeImage(ctx),
-			"gcp_compute_instance":                                    tableGcpComputeInstance(ctx),
-			"gcp_compute_instance_group":                              tableGcpComputeInstanceGroup(ctx),
-			"gcp_compute_instance_group_manager":                      tableGcpComputeInstanceGroupManager(ctx),
-			"gcp_compute_instance_metric_cpu_utilization":             tableGcpComputeInstanceMetricCpuUtilization(ctx),
-			"gcp_compute_instance_metric_cpu_utilization_daily":       tableGcpComputeInstanceMetricCpuUtilizationDaily(ctx),
-			"gcp_compute_instance_metric_cpu_utilization_hourly":      tableGcpComputeInstanceMetricCpuUtilizationHourly(ctx),
-			"gcp_compute_instance_template":                           tableGcpComputeInstanceTemplate(ctx),
-			"gcp_compute_machine_image":                               tableGcpComputeMachineImage(ctx),
-			"gcp_compute_machine_type":                                tableGcpComputeMachineType(ctx),
-			"gcp_compute_network":                                     tableGcpComputeNetwork(ctx),
-			"gcp_compute_node_group":                                  tableGcpComputeNodeGroup(ctx),
-			"gcp_compute_node_template":                               tableGcpComputeNodeTemplate(ctx),
-			"gcp_compute_project_metadata":                            tableGcpComputeProjectMetadata(ctx),
-			"gcp_compute_region":                                      tableGcpComputeRegion(ctx),
-			"gcp_compute_resource_policy":                             tableGcpComputeResourcePolicy(ctx),
-			"gcp_compute_router":                                      tableGcpComputeRouter(ctx),
-			"gcp_compute_snapshot":                                    tableGcpComputeSnapshot(ctx),
-			"gcp_compute_ssl_policy":                                  tableGcpComputeSslPolicy(ctx),
-			"gcp_compute_security_policy":                             tableGcpComputeSecurityPolicy(ctx),
-			"gcp_compute_subnetwork":                                  tableGcpComputeSubnetwork(ctx),
-			"gcp_compute_target_https_proxy":                          tableGcpComputeTargetHttpsProxy(ctx),
-			"gcp_compute_target_pool":                                 tableGcpComputeTargetPool(ctx),
-			"gcp_compute_target_ssl_proxy":                            tableGcpComputeTargetSslProxy(ctx),
-			"gcp_compute_target_vpn_gateway":                          tableGcpComputeTargetVpnGateway(ctx),
-			"gcp_compute_tpu":                                         tableGcpComputeTpu(ctx),
-			"gcp_compute_url_map":                                     tableGcpComputeURLMap(ctx),
-			"gcp_compute_vpn_tunnel":                                  tableGcpComputeVpnTunnel(ctx),
-			"gcp_compute_zone":                                        tableGcpComputeZone(ctx),
-			"gcp_dataplex_asset":                                      tableGcpDataplexAsset(ctx),
-			"gcp_dataplex_lake":                                       tableGcpDataplexLake(ctx),
-			"gcp_dataplex_task":                                       tableGcpDataplexTask(ctx),
-			"gcp_dataplex_zone":                                       tableGcpDataplexZone(ctx),
-			"gcp_dataproc_cluster":                                    tableGcpDataprocCluster(ctx),
-			"gcp_dataproc_metastore_service":                          tableGcpDataprocMetastoreService(ctx),
-			"gcp_dns_managed_zone":                                    tableGcpDnsManagedZone(ctx),
-			"gcp_dns_policy":                                          tableDnsPolicy(ctx),
-			"gcp_dns_record_set":                                      tableDnsRecordSet(ctx),
-			"gcp_firestore_database":                                  tableGcpFirestoreDatabase(ctx),
-			"gcp_iam_policy":                                          tableGcpIAMPolicy(ctx),
-			"gcp_iam_role":                                            tableGcpIamRole(ctx),
-			"gcp_kms_key":                                             tableGcpKmsKey(ctx),
-			"gcp_kms_key_ring":                                        tableGcpKmsKeyRing(ctx),
-			"gcp_kms_key_version":                                     tableGcpKmsKeyVersion(ctx),
-			"gcp_kubernetes_cluster":                                  tableGcpKubernetesCluster(ctx),
-			"gcp_kubernetes_node_pool":                                tableGcpKubernetesNodePool(ctx),
-			"gcp_logging_bucket":                                      tableGcpLoggingBucket(ctx),
-			"gcp_logging_exclusion":                                   tableGcpLoggingExclusion(ctx),
-			"gcp_logging_log_entry":                                   tableGcpLoggingLogEntry(ctx),
-			"gcp_logging_metric":                                      tableGcpLoggingMetric(ctx),
-			"gcp_logging_sink":                                        tableGcpLoggingSink(ctx),
-			"gcp_monitoring_alert_policy":                             tableGcpMonitoringAlert(ctx),
-			"gcp_monitoring_group":                                    tableGcpMonitoringGroup(ctx),
-			"gcp_monitoring_notification_channel":                     tableGcpMonitoringNotificationChannel(ctx),
-			"gcp_organization":                                        tableGcpOrganization(ctx),
-			"gcp_organization_project":                                tableGcpOrganizationProject(ctx),
-			"gcp_project":                                             tableGcpProject(ctx),
-			"gcp_project_organization_policy":                         tableGcpProjectOrganizationPolicy(ctx),
-			"gcp_project_service":                                     tableGcpProjectService(ctx),
-			"gcp_pubsub_snapshot":                                     tableGcpPubSubSnapshot(ctx),
-			"gcp_pubsub_subscription":                                 tableGcpPubSubSubscription(ctx),
-			"gcp_pubsub_topic":                                        tableGcpPubSubTopic(ctx),
-			"gcp_redis_cluster":                                       tableGcpRedisCluster(ctx),
-			"gcp_redis_instance":                                      tableGcpRedisInstance(ctx),
-			"gcp_secret_manager_secret":                               tableGcpSecretManagerSecret(ctx),
-			"gcp_service_account":                                     tableGcpServiceAccount(ctx),
-			"gcp_service_account_key":                                 tableGcpServiceAccountKey(ctx),
-			"gcp_sql_backup":                                          tableGcpSQLBackup(ctx),
-			"gcp_sql_database":                                        tableGcpSQLDatabase(ctx),
-			"gcp_sql_database_instance":                               tableGcpSQLDatabaseInstance(ctx),
-			"gcp_sql_database_instance_metric_connections":            tableGcpSQLDatabaseInstanceMetricConnections(ctx),
-			"gcp_sql_database_instance_metric_connections_daily":      tableGcpSQLDatabaseInstanceMetricConnectionsDaily(ctx),
-			"gcp_sql_database_instance_metric_connections_hourly":     tableGcpSQLDatabaseInstanceMetricConnectionsHourly(ctx),
-			"gcp_sql_database_instance_metric_cpu_utilization":        tableGcpSQLDatabaseInstanceMetricCpuUtilization(ctx),
-			"gcp_sql_database_instance_metric_cpu_utilization_daily":  tableGcpSQLDatabaseInstanceMetricCpuUtilizationDaily(ctx),
-			"gcp_sql_database_instance_metric_cpu_utilization_hourly": tableGcpSQLDatabaseInstanceMetricCpuUtilizationHourly(ctx),
-			"gcp_storage_bucket":                                      tableGcpStorageBucket(ctx),
-			"gcp_storage_object":                                      tableGcpStorageObject(ctx),
-			"gcp_tag_binding":                                         tableGcpTagBinding(ctx),
-			"gcp_tpu_vm":                                              tableGcpTpuVM(ctx),
-			"gcp_vertex_ai_endpoint":                                  tableGcpVertexAIEndpoint(ctx),
-			"gcp_vertex_ai_notebook_runtime_template":                 tableGcpVertexAINotebookRuntimeTemplate(ctx),
-			"gcp_vertex_ai_model":                                     tableGcpVertexAIModel(ctx),
-			"gcp_vpc_access_connector":                                tableGcpVPCAccessConnector(ctx),
-			"gcp_workstations_workstation_cluster":                    tableGcpWorkstationsWorkstationCluster(ctx),
-			"gcp_workstations_workstation":                            tableGcpWorkstationsWorkstation(ctx),
-			/*
-				https://github.com/turbot/steampipe/issues/108
-				"gcp_compute_route":                   tableGcpComputeRoute(ctx),
-			*/
-
-		},
+		TableMapFunc: pluginTableMap,
 	}
 
 	return p
 }
+
+// pluginTableMap builds the tables of a connection. Steampipe builds the tables of a static schema
+// plugin once for the connections it loads together, so they must not depend on the connection config.
+func pluginTableMap(ctx context.Context, d *plugin.TableMapData) (map[string]*plugin.Table, error) {
+	tables := map[string]*plugin.Table{
+		"gcp_alloydb_cluster":                                              tableGcpAlloyDBCluster(ctx),
+		"gcp_alloydb_instance":                                             tableGcpAlloyDBInstance(ctx),
+		"gcp_apikeys_key":                                                  tableGcpApiKeysKey(ctx),
+		"gcp_app_engine_application":                                       tableGcpAppEngineApplication(ctx),
+		"gcp_artifact_registry_repository":                                 tableGcpArtifactRegistryRepository(ctx),
+		"gcp_audit_policy":                                                 tableGcpAuditPolicy(ctx),
+		"gcp_organization_audit_policy":                                    tableGcpOrganizationAuditPolicy(ctx),
+		"gcp_bigquery_dataset":                                             tableGcpBigQueryDataset(ctx),
+		"gcp_bigquery_job":                                                 tableGcpBigQueryJob(ctx),
+		"gcp_bigquery_table":                                               tableGcpBigqueryTable(ctx),
+		"gcp_bigtable_instance":                                            tableGcpBigtableInstance(ctx),
+		"gcp_billing_account":                                              tableGcpBillingAccount(ctx),
+		"gcp_billing_budget":                                               tableGcpBillingBudget(ctx),
+		"gcp_cloud_asset":                                                  tableGcpCloudAsset(ctx),
+		"gcp_cloud_asset_iam_policy_search":                                tableGcpCloudAssetIamPolicySearch(ctx),
+		"gcp_cloud_asset_resource_search":                                  tableGcpCloudAssetResourceSearch(ctx),
+		"gcp_cloud_identity_group":                                         tableGcpCloudIdentityGroup(ctx),
+		"gcp_cloud_identity_group_membership":                              tableGcpCloudIdentityGroupMembership(ctx),
+		"gcp_cloudfunctions_function":                                      tableGcpCloudfunctionFunction(ctx),
+		"gcp_cloud_run_job":                                                tableGcpCloudRunJob(ctx),
+		"gcp_cloud_run_service":                                            tableGcpCloudRunService(ctx),
+		"gcp_cloud_run_service_metric_request_count":                       tableGcpCloudRunServiceMetricRequestCount(ctx),
+		"gcp_cloud_run_service_metric_request_count_daily":                 tableGcpCloudRunServiceMetricRequestCountDaily(ctx),
+		"gcp_cloud_run_service_metric_request_count_hourly":                tableGcpCloudRunServiceMetricRequestCountHourly(ctx),
+		"gcp_cloud_run_service_metric_request_latencies":                   tableGcpCloudRunServiceMetricRequestLatencies(ctx),
+		"gcp_cloud_run_service_metric_request_latencies_daily":             tableGcpCloudRunServiceMetricRequestLatenciesDaily(ctx),
+		"gcp_cloud_run_service_metric_request_latencies_hourly":            tableGcpCloudRunServiceMetricRequestLatenciesHourly(ctx),
+		"gcp_composer_environment":                                         tableGcpComposerEnvironment(ctx),
+		"gcp_compute_address":                                              tableGcpComputeAddress(ctx),
+		"gcp_compute_autoscaler":                                           tableGcpComputeAutoscaler(ctx),
+		"gcp_compute_backend_bucket":                                       tableGcpComputeBackendBucket(ctx),
+		"gcp_compute_backend_service":                                      tableGcpComputeBackendService(ctx),
+		"gcp_compute_disk":                                                 tableGcpComputeDisk(ctx),
+		"gcp_compute_disk_iam_binding":                                     tableGcpComputeDiskIamBinding(ctx),
+		"gcp_compute_disk_metric_read_ops":                                 tableGcpComputeDiskMetricReadOps(ctx),
+		"gcp_compute_disk_metric_read_ops_daily":                           tableGcpComputeDiskMetricReadOpsDaily(ctx),
+		"gcp_compute_disk_metric_read_ops_hourly":                          tableGcpComputeDiskMetricReadOpsHourly(ctx),
+		"gcp_compute_disk_metric_write_ops":                                tableGcpComputeDiskMetricWriteOps(ctx),
+		"gcp_compute_disk_metric_write_ops_daily":                          tableGcpComputeDiskMetricWriteOpsDaily(ctx),
+		"gcp_compute_disk_metric_write_ops_hourly":                         tableGcpComputeDiskMetricWriteOpsHourly(ctx),
+		"gcp_compute_firewall":                                             tableGcpComputeFirewall(ctx),
+		"gcp_compute_forwarding_rule":                                      tableGcpComputeForwardingRule(ctx),
+		"gcp_compute_global_address":                                       tableGcpComputeGlobalAddress(ctx),
+		"gcp_compute_global_forwarding_rule":                               tableGcpComputeGlobalForwardingRule(ctx),
+		"gcp_compute_ha_vpn_gateway":                                       tableGcpComputeHaVpnGateway(ctx),
+		"gcp_compute_image":                                                tableGcpComputeImage(ctx),
+		"gcp_compute_image_iam_binding":                                    tableGcpComputeImageIamBinding(ctx),
+		"gcp_compute_instance":                                             tableGcpComputeInstance(ctx),
+		"gcp_compute_instance_iam_binding":                                 tableGcpComputeInstanceIamBinding(ctx),
+		"gcp_compute_instance_group":                                       tableGcpComputeInstanceGroup(ctx),
+		"gcp_compute_instance_group_manager":                               tableGcpComputeInstanceGroupManager(ctx),
+		"gcp_compute_instance_metric_cpu_utilization":                      tableGcpComputeInstanceMetricCpuUtilization(ctx),
+		"gcp_compute_instance_metric_cpu_utilization_daily":                tableGcpComputeInstanceMetricCpuUtilizationDaily(ctx),
+		"gcp_compute_instance_metric_cpu_utilization_hourly":               tableGcpComputeInstanceMetricCpuUtilizationHourly(ctx),
+		"gcp_compute_instance_template":                                    tableGcpComputeInstanceTemplate(ctx),
+		"gcp_compute_machine_image":                                        tableGcpComputeMachineImage(ctx),
+		"gcp_compute_machine_type":                                         tableGcpComputeMachineType(ctx),
+		"gcp_compute_network":                                              tableGcpComputeNetwork(ctx),
+		"gcp_compute_node_group":                                           tableGcpComputeNodeGroup(ctx),
+		"gcp_compute_node_template":                                        tableGcpComputeNodeTemplate(ctx),
+		"gcp_compute_project_metadata":                                     tableGcpComputeProjectMetadata(ctx),
+		"gcp_compute_region":                                               tableGcpComputeRegion(ctx),
+		"gcp_compute_resource_policy":                                      tableGcpComputeResourcePolicy(ctx),
+		"gcp_compute_router":                                               tableGcpComputeRouter(ctx),
+		"gcp_compute_snapshot":                                             tableGcpComputeSnapshot(ctx),
+		"gcp_compute_ssl_policy":                                           tableGcpComputeSslPolicy(ctx),
+		"gcp_compute_security_policy":                                      tableGcpComputeSecurityPolicy(ctx),
+		"gcp_compute_subnetwork":                                           tableGcpComputeSubnetwork(ctx),
+		"gcp_compute_subnetwork_iam_binding":                               tableGcpComputeSubnetworkIamBinding(ctx),
+		"gcp_compute_target_https_proxy":                                   tableGcpComputeTargetHttpsProxy(ctx),
+		"gcp_compute_target_pool":                                          tableGcpComputeTargetPool(ctx),
+		"gcp_compute_target_ssl_proxy":                                     tableGcpComputeTargetSslProxy(ctx),
+		"gcp_compute_target_vpn_gateway":                                   tableGcpComputeTargetVpnGateway(ctx),
+		"gcp_compute_tpu":                                                  tableGcpComputeTpu(ctx),
+		"gcp_compute_url_map":                                              tableGcpComputeURLMap(ctx),
+		"gcp_compute_url_map_metric_request_count":                         tableGcpComputeURLMapMetricRequestCount(ctx),
+		"gcp_compute_url_map_metric_request_count_daily":                   tableGcpComputeURLMapMetricRequestCountDaily(ctx),
+		"gcp_compute_url_map_metric_request_count_hourly":                  tableGcpComputeURLMapMetricRequestCountHourly(ctx),
+		"gcp_compute_vpn_tunnel":                                           tableGcpComputeVpnTunnel(ctx),
+		"gcp_compute_zone":                                                 tableGcpComputeZone(ctx),
+		"gcp_dataplex_asset":                                               tableGcpDataplexAsset(ctx),
+		"gcp_dataplex_lake":                                                tableGcpDataplexLake(ctx),
+		"gcp_dataplex_task":                                                tableGcpDataplexTask(ctx),
+		"gcp_dataplex_zone":                                                tableGcpDataplexZone(ctx),
+		"gcp_dataproc_cluster":                                             tableGcpDataprocCluster(ctx),
+		"gcp_dataproc_metastore_service":                                   tableGcpDataprocMetastoreService(ctx),
+		"gcp_dns_managed_zone":                                             tableGcpDnsManagedZone(ctx),
+		"gcp_dns_policy":                                                   tableDnsPolicy(ctx),
+		"gcp_dns_record_set":                                               tableDnsRecordSet(ctx),
+		"gcp_firestore_database":                                           tableGcpFirestoreDatabase(ctx),
+		"gcp_iam_effective_binding":                                        tableGcpIAMEffectiveBinding(ctx),
+		"gcp_iam_policy":                                                   tableGcpIAMPolicy(ctx),
+		"gcp_iam_role":                                                     tableGcpIamRole(ctx),
+		"gcp_kms_key":                                                      tableGcpKmsKey(ctx),
+		"gcp_kms_key_iam_binding":                                          tableGcpKmsKeyIamBinding(ctx),
+		"gcp_kms_key_ring":                                                 tableGcpKmsKeyRing(ctx),
+		"gcp_kms_key_version":                                              tableGcpKmsKeyVersion(ctx),
+		"gcp_kubernetes_cluster":                                           tableGcpKubernetesCluster(ctx),
+		"gcp_kubernetes_cluster_metric_node_cpu_utilization":               tableGcpKubernetesClusterMetricNodeCpuUtilization(ctx),
+		"gcp_kubernetes_cluster_metric_node_cpu_utilization_daily":         tableGcpKubernetesClusterMetricNodeCpuUtilizationDaily(ctx),
+		"gcp_kubernetes_cluster_metric_node_cpu_utilization_hourly":        tableGcpKubernetesClusterMetricNodeCpuUtilizationHourly(ctx),
+		"gcp_kubernetes_cluster_metric_node_memory_utilization":            tableGcpKubernetesClusterMetricNodeMemoryUtilization(ctx),
+		"gcp_kubernetes_cluster_metric_node_memory_utilization_daily":      tableGcpKubernetesClusterMetricNodeMemoryUtilizationDaily(ctx),
+		"gcp_kubernetes_cluster_metric_node_memory_utilization_hourly":     tableGcpKubernetesClusterMetricNodeMemoryUtilizationHourly(ctx),
+		"gcp_kubernetes_node_pool":                                         tableGcpKubernetesNodePool(ctx),
+		"gcp_logging_bucket":                                               tableGcpLoggingBucket(ctx),
+		"gcp_logging_exclusion":                                            tableGcpLoggingExclusion(ctx),
+		"gcp_logging_log_entry":                                            tableGcpLoggingLogEntry(ctx),
+		"gcp_logging_metric":                                               tableGcpLoggingMetric(ctx),
+		"gcp_logging_sink":                                                 tableGcpLoggingSink(ctx),
+		"gcp_monitoring_alert_policy":                                      tableGcpMonitoringAlert(ctx),
+		"gcp_monitoring_group":                                             tableGcpMonitoringGroup(ctx),
+		"gcp_monitoring_mql_query":                                         tableGcpMonitoringMQLQuery(ctx),
+		"gcp_monitoring_notification_channel":                              tableGcpMonitoringNotificationChannel(ctx),
+		"gcp_monitoring_promql_query":                                      tableGcpMonitoringPromQLQuery(ctx),
+		"gcp_monitoring_time_series":                                       tableGcpMonitoringTimeSeries(ctx),
+		"gcp_organization":                                                 tableGcpOrganization(ctx),
+		"gcp_organization_project":                                         tableGcpOrganizationProject(ctx),
+		"gcp_project":                                                      tableGcpProject(ctx),
+		"gcp_project_organization_policy":                                  tableGcpProjectOrganizationPolicy(ctx),
+		"gcp_project_service":                                              tableGcpProjectService(ctx),
+		"gcp_pubsub_snapshot":                                              tableGcpPubSubSnapshot(ctx),
+		"gcp_pubsub_subscription":                                          tableGcpPubSubSubscription(ctx),
+		"gcp_pubsub_subscription_iam_binding":                              tableGcpPubSubSubscriptionIamBinding(ctx),
+		"gcp_pubsub_subscription_metric_num_undelivered_messages":          tableGcpPubSubSubscriptionMetricNumUndeliveredMessages(ctx),
+		"gcp_pubsub_subscription_metric_num_undelivered_messages_daily":    tableGcpPubSubSubscriptionMetricNumUndeliveredMessagesDaily(ctx),
+		"gcp_pubsub_subscription_metric_num_undelivered_messages_hourly":   tableGcpPubSubSubscriptionMetricNumUndeliveredMessagesHourly(ctx),
+		"gcp_pubsub_subscription_metric_oldest_unacked_message_age":        tableGcpPubSubSubscriptionMetricOldestUnackedMessageAge(ctx),
+		"gcp_pubsub_subscription_metric_oldest_unacked_message_age_daily":  tableGcpPubSubSubscriptionMetricOldestUnackedMessageAgeDaily(ctx),
+		"gcp_pubsub_subscription_metric_oldest_unacked_message_age_hourly": tableGcpPubSubSubscriptionMetricOldestUnackedMessageAgeHourly(ctx),
+		"gcp_pubsub_topic":                                                 tableGcpPubSubTopic(ctx),
+		"gcp_pubsub_topic_iam_binding":                                     tableGcpPubSubTopicIamBinding(ctx),
+		"gcp_redis_cluster":                                                tableGcpRedisCluster(ctx),
+		"gcp_redis_instance":                                               tableGcpRedisInstance(ctx),
+		"gcp_secret_manager_secret":                                        tableGcpSecretManagerSecret(ctx),
+		"gcp_service_account":                                              tableGcpServiceAccount(ctx),
+		"gcp_service_account_key":                                          tableGcpServiceAccountKey(ctx),
+		"gcp_sql_backup":                                                   tableGcpSQLBackup(ctx),
+		"gcp_sql_database":                                                 tableGcpSQLDatabase(ctx),
+		"gcp_sql_database_instance":                                        tableGcpSQLDatabaseInstance(ctx),
+		"gcp_sql_database_instance_metric_connections":                     tableGcpSQLDatabaseInstanceMetricConnections(ctx),
+		"gcp_sql_database_instance_metric_connections_daily":               tableGcpSQLDatabaseInstanceMetricConnectionsDaily(ctx),
+		"gcp_sql_database_instance_metric_connections_hourly":              tableGcpSQLDatabaseInstanceMetricConnectionsHourly(ctx),
+		"gcp_sql_database_instance_metric_cpu_utilization":                 tableGcpSQLDatabaseInstanceMetricCpuUtilization(ctx),
+		"gcp_sql_database_instance_metric_cpu_utilization_daily":           tableGcpSQLDatabaseInstanceMetricCpuUtilizationDaily(ctx),
+		"gcp_sql_database_instance_metric_cpu_utilization_hourly":          tableGcpSQLDatabaseInstanceMetricCpuUtilizationHourly(ctx),
+		"gcp_storage_bucket":                                               tableGcpStorageBucket(ctx),
+		"gcp_storage_bucket_iam_binding":                                   tableGcpStorageBucketIamBinding(ctx),
+		"gcp_storage_bucket_metric_total_bytes":                            tableGcpStorageBucketMetricTotalBytes(ctx),
+		"gcp_storage_bucket_metric_total_bytes_daily":                      tableGcpStorageBucketMetricTotalBytesDaily(ctx),
+		"gcp_storage_bucket_metric_total_bytes_hourly":                     tableGcpStorageBucketMetricTotalBytesHourly(ctx),
+		"gcp_storage_object":                                               tableGcpStorageObject(ctx),
+		"gcp_tag_binding":                                                  tableGcpTagBinding(ctx),
+		"gcp_tpu_vm":                                                       tableGcpTpuVM(ctx),
+		"gcp_vertex_ai_endpoint":                                           tableGcpVertexAIEndpoint(ctx),
+		"gcp_vertex_ai_notebook_runtime_template":                          tableGcpVertexAINotebookRuntimeTemplate(ctx),
+		"gcp_vertex_ai_model":                                              tableGcpVertexAIModel(ctx),
+		"gcp_vpc_access_connector":                                         tableGcpVPCAccessConnector(ctx),
+		"gcp_workstations_workstation_cluster":                             tableGcpWorkstationsWorkstationCluster(ctx),
+		"gcp_workstations_workstation":                                     tableGcpWorkstationsWorkstation(ctx),
+		/*
+			https://github.com/turbot/steampipe/issues/108
+			"gcp_compute_route":                   tableGcpComputeRoute(ctx),
+		*/
+
+	}
+
+	for _, table := range tables {
+		// Fan every project-scoped table out over the connection's projects
+		setProjectMatrix(table)
+	}
+
+	return tables, nil
+}
@@ -0,0 +1,65 @@
+package gcp
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// Every service tagged by a table must be throttled by a rate limiter, so a new table or service
+// does not silently run unthrottled.
+func TestRateLimitersCoverEveryService(t *testing.T) {
+	ctx := context.Background()
+	p := Plugin(ctx)
+
+	tables, err := p.TableMapFunc(ctx, &plugin.TableMapData{Connection: &plugin.Connection{Name: "gcp"}})
+	if err != nil {
+		t.Fatalf("building the table map: %v", err)
+	}
+
+	for _, limiter := range p.RateLimiters {
+		if err := limiter.Initialise(); err != nil {
+			t.Fatalf("rate limiter %s: %v", limiter.Name, err)
+		}
+	}
+
+	// tags of every list, get and hydrate config, keyed by where they are declared
+	tags := map[string]map[string]string{}
+	for name, table := range tables {
+		if table.List != nil {
+			tags[name+" list"] = table.List.Tags
+		}
+		if table.Get != nil {
+			tags[name+" get"] = table.Get.Tags
+		}
+		for _, h := range table.HydrateConfig {
+			if h.Tags != nil {
+				tags[name+" hydrate "+h.Tags["action"]] = h.Tags
+			}
+		}
+	}
+
+	var uncovered []string
+	for declaredBy, values := range tags {
+		if values["service"] == "" {
+			continue
+		}
+		covered := false
+		for _, limiter := range p.RateLimiters {
+			if limiter.Where != "" && limiter.SatisfiesFilters(values) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			uncovered = append(uncovered, declaredBy+" (service = '"+values["service"]+"', action = '"+values["action"]+"')")
+		}
+	}
+
+	sort.Strings(uncovered)
+	for _, u := range uncovered {
+		t.Errorf("no rate limiter matches the tags of %s", u)
+	}
+}
@@ -0,0 +1,279 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	cloudresourcemanager3 "google.golang.org/api/cloudresourcemanager/v3"
+)
+
+const (
+	matrixKeyProject = "project"
+
+	// matrixKeyQuotaProject is added to every matrix item, which makes the effective
+	// quota project available to the rate limiters as the `quota_project` scope
+	matrixKeyQuotaProject = "quota_project"
+
+	// matrixKeyLocationError marks the matrix item of a failed project or location
+	// lookup, so its error is returned by the query instead of being swallowed into
+	// an empty result. See getMatrixLocationError.
+	matrixKeyLocationError = "location_list_error"
+)
+
+// BuildProjectList :: return a list of matrix items, one per project the connection is scoped to
+func BuildProjectList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
+	projects, err := getProjectList(ctx, d)
+	if err != nil {
+		return []map[string]interface{}{locationListFailure(ctx, d, "BuildProjectList", "cloudresourcemanager.projects.list", "", err)}
+	}
+
+	matrix := make([]map[string]interface{}, len(projects))
+	for i, project := range projects {
+		matrix[i] = map[string]interface{}{matrixKeyProject: project}
+	}
+	return matrix
+}
+
+// locationListFailure logs a failed matrix lookup and returns a matrix item marking
+// the failure. The SDK turns matrix values into quals, so the item only holds a key
+// string and the error itself is kept in the connection cache. The original error is
+// kept as-is, so `ignore_error_codes` still applies when it is returned.
+func locationListFailure(ctx context.Context, d *plugin.QueryData, builder string, api string, project string, err error) map[string]interface{} {
+	plugin.Logger(ctx).Warn(builder, "api", api, "project", project, "error", err)
+
+	errorKey := builder + "/" + project
+	d.ConnectionManager.Cache.Set("LocationListError/"+errorKey, err)
+
+	item := map[string]interface{}{matrixKeyLocationError: errorKey}
+	if project != "" {
+		item[matrixKeyProject] = project
+	}
+	return item
+}
+
+// getMatrixLocationError returns the error of a failed project or location lookup, if
+// the current matrix item records one. Functions that skip matrix items by location
+// must check it first, since a failed lookup has no location to compare.
+func getMatrixLocationError(ctx context.Context, d *plugin.QueryData) error {
+	errorKey, ok := plugin.GetMatrixItem(ctx)[matrixKeyLocationError].(string)
+	if !ok || errorKey == "" {
+		return nil
+	}
+	if cachedData, ok := d.ConnectionManager.Cache.Get("LocationListError/" + errorKey); ok {
+		return cachedData.(error)
+	}
+	return fmt.Errorf("%s: listing projects or locations failed", errorKey)
+}
+
+// getProjectList returns the IDs of all projects the connection is scoped to.
+//
+// If the `projects` config argument is set, each entry is either a project ID or
+// a wildcard pattern (e.g. "prod-*") that is matched against the IDs of all ACTIVE
+// projects visible to the credentials. If `organization` or `folders` are set, all
+// ACTIVE projects beneath them are added too. Otherwise the single active project is used.
+func getProjectList(ctx context.Context, d *plugin.QueryData) ([]string, error) {
+	// have we already resolved and cached the projects?
+	projectCacheKey := "ProjectList"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(projectCacheKey); ok {
+		return cachedData.([]string), nil
+	}
+
+	gcpConfig := GetConfig(d.Connection)
+
+	var projects []string
+	if len(gcpConfig.Projects) == 0 && gcpConfig.Organization == nil && len(gcpConfig.Folders) == 0 {
+		projectData, err := activeProject(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+		projects = []string{projectData.Project}
+	} else {
+		var patterns []string
+		for _, project := range gcpConfig.Projects {
+			if strings.ContainsAny(project, "*?[") {
+				patterns = append(patterns, project)
+			} else if !slices.Contains(projects, project) {
+				projects = append(projects, project)
+			}
+		}
+
+		if len(patterns) > 0 {
+			visibleProjects, err := listActiveProjectIds(ctx, d)
+			if err != nil {
+				return nil, err
+			}
+			for _, project := range visibleProjects {
+				if slices.Contains(projects, project) {
+					continue
+				}
+				for _, pattern := range patterns {
+					if ok, _ := path.Match(pattern, project); ok {
+						projects = append(projects, project)
+						break
+					}
+				}
+			}
+		}
+
+		var parents []string
+		if gcpConfig.Organization != nil {
+			parents = append(parents, "organizations/"+strings.TrimPrefix(*gcpConfig.Organization, "organizations/"))
+		}
+		for _, folder := range gcpConfig.Folders {
+			parents = append(parents, "folders/"+strings.TrimPrefix(folder, "folders/"))
+		}
+
+		for _, parent := range parents {
+			descendantProjects, err := listDescendantProjectIds(ctx, d, parent)
+			if err != nil {
+				return nil, err
+			}
+			for _, project := range descendantProjects {
+				if !slices.Contains(projects, project) {
+					projects = append(projects, project)
+				}
+			}
+		}
+	}
+
+	plugin.Logger(ctx).Debug("getProjectList", "connection_name", d.Connection.Name, "projects", projects)
+
+	d.ConnectionManager.Cache.Set(projectCacheKey, projects)
+	return projects, nil
+}
+
+// listActiveProjectIds returns the IDs of all ACTIVE projects the credentials can see
+func listActiveProjectIds(ctx context.Context, d *plugin.QueryData) ([]string, error) {
+	service, err := CloudResourceManagerService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	var projectIds []string
+	resp := service.Projects.List().Filter("lifecycleState:ACTIVE").PageSize(500)
+	if err := resp.Pages(ctx, func(page *cloudresourcemanager.ListProjectsResponse) error {
+		for _, project := range page.Projects {
+			projectIds = append(projectIds, project.ProjectId)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return projectIds, nil
+}
+
+// listDescendantProjectIds walks the resource hierarchy below an organization or
+// folder (e.g. "organizations/123" or "folders/456") and returns the IDs of all
+// ACTIVE projects found at any depth
+func listDescendantProjectIds(ctx context.Context, d *plugin.QueryData, parent string) ([]string, error) {
+	service, err := CloudResourceManagerServiceV3(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	var projectIds []string
+	parents := []string{parent}
+	for len(parents) > 0 {
+		parent, parents = parents[0], parents[1:]
+
+		projectsResp := service.Projects.List().Parent(parent)
+		if err := projectsResp.Pages(ctx, func(page *cloudresourcemanager3.ListProjectsResponse) error {
+			for _, project := range page.Projects {
+				if project.State == "ACTIVE" {
+					projectIds = append(projectIds, project.ProjectId)
+				}
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+
+		foldersResp := service.Folders.List().Parent(parent)
+		if err := foldersResp.Pages(ctx, func(page *cloudresourcemanager3.ListFoldersResponse) error {
+			for _, folder := range page.Folders {
+				if folder.State == "ACTIVE" {
+					parents = append(parents, folder.Name)
+				}
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return projectIds, nil
+}
+
+// setProjectMatrix fans a table out over the connection's projects.
+//
+// Tables without their own matrix get one item per project, while the location
+// matrix builders already emit a project key alongside each location. The project
+// column is registered as an optional key column, so a `where project = '...'`
+// qual prunes the matrix before any API call is made. Get calls are fanned out too,
+// so a key which is not unique across the projects fails without a project qual.
+//
+// The project is not a connection key column, which only holds one value per
+// connection and would drop multi-project connections from aggregator queries.
+//
+// Every table's matrix items also carry the effective quota project, see setQuotaProjectMatrix.
+func setProjectMatrix(table *plugin.Table) {
+	hasProjectColumn := false
+	for _, column := range table.Columns {
+		if column.Name == matrixKeyProject {
+			hasProjectColumn = true
+			break
+		}
+	}
+	if hasProjectColumn {
+		if table.GetMatrixItemFunc == nil {
+			table.GetMatrixItemFunc = BuildProjectList
+		}
+
+		projectKeyColumn := &plugin.KeyColumn{Name: matrixKeyProject, Require: plugin.Optional, Operators: []string{"="}}
+		if table.List != nil && table.List.KeyColumns.Find(matrixKeyProject) == nil {
+			table.List.KeyColumns = append(table.List.KeyColumns, projectKeyColumn)
+		}
+		if table.Get != nil && table.Get.KeyColumns.Find(matrixKeyProject) == nil {
+			table.Get.KeyColumns = append(table.Get.KeyColumns, projectKeyColumn)
+		}
+	}
+
+	setQuotaProjectMatrix(table)
+}
+
+// setQuotaProjectMatrix adds the effective quota project to each of the table's
+// matrix items, giving tables without a matrix a single item. Connections that
+// share a quota project then share the buckets of rate limiters scoped by
+// `quota_project`. Without a `quota_project` argument or GOOGLE_CLOUD_QUOTA_PROJECT
+// the connection name is used, so each connection keeps its own buckets.
+func setQuotaProjectMatrix(table *plugin.Table) {
+	buildMatrix := table.GetMatrixItemFunc
+	table.GetMatrixItemFunc = func(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
+		quotaProject := getQuotaProject(GetConfig(d.Connection))
+		if quotaProject == "" {
+			quotaProject = d.Connection.Name
+		}
+
+		if buildMatrix == nil {
+			return []map[string]interface{}{{matrixKeyQuotaProject: quotaProject}}
+		}
+
+		// the builders cache their matrix, so copy the items rather than modify them
+		matrix := buildMatrix(ctx, d)
+		quotaMatrix := make([]map[string]interface{}, len(matrix))
+		for i, item := range matrix {
+			quotaMatrix[i] = make(map[string]interface{}, len(item)+1)
+			for key, value := range item {
+				quotaMatrix[i][key] = value
+			}
+			quotaMatrix[i][matrixKeyQuotaProject] = quotaProject
+		}
+		return quotaMatrix
+	}
+}
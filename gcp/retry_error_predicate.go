@@ -0,0 +1,107 @@
+package gcp
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/googleapis/gax-go/v2/apierror"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// defaults for the "max_error_retry_attempts" and "min_error_retry_delay" config arguments
+	defaultMaxErrorRetryAttempts = 9
+	defaultMinErrorRetryDelay    = 25
+
+	// maxErrorRetryDelay caps the exponential backoff, in milliseconds
+	maxErrorRetryDelay = 60000
+)
+
+// shouldRetryErrorPluginDefault:: Plugin level default function to retry errors of requests that
+// never reached the API, i.e. connection resets, truncated responses and network timeouts.
+//
+// Throttling and transient API errors are retried by the API clients themselves, with the
+// connection's backoff (see errorRetryPolicy), so they are not retried again here.
+func shouldRetryErrorPluginDefault() plugin.ErrorPredicateWithContext {
+	return func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, err error) bool {
+		if !isTransientNetworkError(err) {
+			return false
+		}
+		plugin.Logger(ctx).Debug("retry_error_predicate.shouldRetryErrorPluginDefault", "notice", "retrying network error", "error", err)
+		return true
+	}
+}
+
+// isTransientNetworkError reports whether a request failed before an API response was
+// received, because the connection was reset or closed early or the request timed out.
+// Cancelled queries and expired query deadlines are not transient.
+func isTransientNetworkError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// isRetryableError reports whether an error is caused by throttling or a transient
+// server error, i.e. HTTP 429/500/502/503/504, the gRPC RESOURCE_EXHAUSTED and
+// UNAVAILABLE codes, or a rate limit error reason (some APIs throttle with a 403)
+func isRetryableError(err error) bool {
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) {
+		switch gerr.Code {
+		case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+	}
+
+	if apiErr, ok := apierror.FromError(err); ok && apiErr.GRPCStatus() != nil {
+		switch apiErr.GRPCStatus().Code() {
+		case codes.ResourceExhausted, codes.Unavailable:
+			return true
+		}
+	}
+
+	for _, reason := range errorReasons(err) {
+		if slices.Contains([]string{"RATE_LIMIT_EXCEEDED", "rateLimitExceeded", "userRateLimitExceeded"}, reason) {
+			return true
+		}
+	}
+	return false
+}
+
+// retryAfter returns the delay the server asked for, from the Retry-After header of
+// a REST error (in seconds or as an HTTP date) or the RetryInfo detail of a gRPC error
+func retryAfter(err error) (time.Duration, bool) {
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) && gerr.Header != nil {
+		if value := gerr.Header.Get("Retry-After"); value != "" {
+			if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+				return time.Duration(seconds) * time.Second, true
+			}
+			if date, err := http.ParseTime(value); err == nil {
+				return max(time.Until(date), 0), true
+			}
+		}
+	}
+
+	if apiErr, ok := apierror.FromError(err); ok {
+		if retryInfo := apiErr.Details().RetryInfo; retryInfo != nil && retryInfo.GetRetryDelay() != nil {
+			return retryInfo.GetRetryDelay().AsDuration(), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,97 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func grpcError(code codes.Code, retryDelay time.Duration) error {
+	st := status.New(code, "error")
+	if retryDelay > 0 {
+		st, _ = st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryDelay)})
+	}
+	return st.Err()
+}
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"429", &googleapi.Error{Code: http.StatusTooManyRequests}, true},
+		{"503", &googleapi.Error{Code: http.StatusServiceUnavailable}, true},
+		{"wrapped 500", fmt.Errorf("listing: %w", &googleapi.Error{Code: http.StatusInternalServerError}), true},
+		{"403 rate limit reason", &googleapi.Error{Code: http.StatusForbidden, Errors: []googleapi.ErrorItem{{Reason: "rateLimitExceeded"}}}, true},
+		{"403 permission denied", &googleapi.Error{Code: http.StatusForbidden, Errors: []googleapi.ErrorItem{{Reason: "forbidden"}}}, false},
+		{"404", &googleapi.Error{Code: http.StatusNotFound}, false},
+		{"RESOURCE_EXHAUSTED", grpcError(codes.ResourceExhausted, 0), true},
+		{"UNAVAILABLE", grpcError(codes.Unavailable, 0), true},
+		{"NOT_FOUND", grpcError(codes.NotFound, 0), false},
+		{"other error", fmt.Errorf("boom"), false},
+	}
+	for _, test := range tests {
+		if got := isRetryableError(test.err); got != test.want {
+			t.Errorf("isRetryableError(%s) = %t, want %t", test.name, got, test.want)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		want   time.Duration
+		wantOk bool
+	}{
+		{"seconds", &googleapi.Error{Code: 429, Header: http.Header{"Retry-After": {"5"}}}, 5 * time.Second, true},
+		{"date in the past", &googleapi.Error{Code: 503, Header: http.Header{"Retry-After": {"Mon, 02 Jan 2006 15:04:05 GMT"}}}, 0, true},
+		{"invalid", &googleapi.Error{Code: 503, Header: http.Header{"Retry-After": {"soon"}}}, 0, false},
+		{"no header", &googleapi.Error{Code: 503}, 0, false},
+		{"RetryInfo", grpcError(codes.ResourceExhausted, 3*time.Second), 3 * time.Second, true},
+		{"no RetryInfo", grpcError(codes.Unavailable, 0), 0, false},
+	}
+	for _, test := range tests {
+		got, ok := retryAfter(test.err)
+		if got != test.want || ok != test.wantOk {
+			t.Errorf("retryAfter(%s) = %v, %t, want %v, %t", test.name, got, ok, test.want, test.wantOk)
+		}
+	}
+
+	// an HTTP date is converted to the delay from now
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if got, ok := retryAfter(&googleapi.Error{Code: 429, Header: http.Header{"Retry-After": {date}}}); !ok || got <= 58*time.Second || got > time.Minute {
+		t.Errorf("retryAfter(%s) = %v, %t, want about a minute", date, got, ok)
+	}
+}
+
+func TestIsTransientNetworkError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"connection reset", &url.Error{Op: "Get", URL: "https://example.com", Err: syscall.ECONNRESET}, true},
+		{"unexpected EOF", fmt.Errorf("reading body: %w", io.ErrUnexpectedEOF), true},
+		{"cancelled", &url.Error{Op: "Get", URL: "https://example.com", Err: context.Canceled}, false},
+		{"deadline exceeded", &url.Error{Op: "Get", URL: "https://example.com", Err: context.DeadlineExceeded}, false},
+		{"API error", &googleapi.Error{Code: http.StatusServiceUnavailable}, false},
+	}
+	for _, test := range tests {
+		if got := isTransientNetworkError(test.err); got != test.want {
+			t.Errorf("isTransientNetworkError(%s) = %t, want %t", test.name, got, test.want)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	aiplatform "cloud.google.com/go/aiplatform/apiv1"
 	redis "cloud.google.com/go/redis/apiv1"
 	rediscluster "cloud.google.com/go/redis/cluster/apiv1"
+	resourcemanager "cloud.google.com/go/resourcemanager/apiv3"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"google.golang.org/api/accessapproval/v1"
 	"google.golang.org/api/alloydb/v1"
@@ -21,6 +22,7 @@ import (
 	"google.golang.org/api/cloudidentity/v1"
 	"google.golang.org/api/cloudkms/v1"
 	"google.golang.org/api/cloudresourcemanager/v1"
+	cloudresourcemanager3 "google.golang.org/api/cloudresourcemanager/v3"
 	"google.golang.org/api/composer/v1"
 	"google.golang.org/api/compute/v1"
 	"google.golang.org/api/container/v1"
@@ -32,6 +34,7 @@ import (
 	"google.golang.org/api/iam/v1"
 	"google.golang.org/api/logging/v2"
 	"google.golang.org/api/metastore/v1"
+	monitoring1 "google.golang.org/api/monitoring/v1"
 	"google.golang.org/api/monitoring/v3"
 	"google.golang.org/api/option"
 	"google.golang.org/api/pubsub/v1"
@@ -56,11 +59,8 @@ func AccessApprovalService(ctx context.Context, d *plugin.QueryData) (*accessapp
 		return cachedData.(*accessapproval.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := accessapproval.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "accessapproval", "", accessapproval.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -93,17 +93,31 @@ func AIService(ctx context.Context, d *plugin.QueryData, clientType string) (*AI
 	}
 
 	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-	opts = append(opts, option.WithEndpoint(matrixLocation+"-aiplatform.googleapis.com:443"))
+	opts, err := setGRPCSessionConfig(ctx, d, "aiplatform")
+	if err != nil {
+		return nil, err
+	}
+
+	// Vertex AI is served from regional endpoints unless a custom endpoint is configured
+	gcpConfig := GetConfig(d.Connection)
+	if _, ok := gcpConfig.Endpoints["aiplatform"]; !ok {
+		opts = append(opts, option.WithEndpoint(matrixLocation+"-aiplatform."+getUniverseDomain(gcpConfig)+":443"))
+	}
 
 	clients := &AIplatfromServiceClients{}
 
+	// retry the calls with the connection's retry policy
+	retry := grpcRetryCallOption(d.Connection)
+
 	switch clientType {
 	case "Endpoint":
 		svc, err := aiplatform.NewEndpointClient(ctx, opts...)
 		if err != nil {
 			return nil, err
 		}
+		svc.CallOptions.ListEndpoints = append(svc.CallOptions.ListEndpoints, retry)
+		svc.CallOptions.GetEndpoint = append(svc.CallOptions.GetEndpoint, retry)
+		svc.CallOptions.ListLocations = append(svc.CallOptions.ListLocations, retry)
 		clients.Endpoint = svc
 		return clients, nil
 	case "Dataset":
@@ -111,6 +125,7 @@ func AIService(ctx context.Context, d *plugin.QueryData, clientType string) (*AI
 		if err != nil {
 			return nil, err
 		}
+		svc.CallOptions.ListLocations = append(svc.CallOptions.ListLocations, retry)
 		clients.Dataset = svc
 		return clients, nil
 	case "Index":
@@ -118,6 +133,7 @@ func AIService(ctx context.Context, d *plugin.QueryData, clientType string) (*AI
 		if err != nil {
 			return nil, err
 		}
+		svc.CallOptions.ListLocations = append(svc.CallOptions.ListLocations, retry)
 		clients.Index = svc
 		return clients, nil
 	case "Job":
@@ -125,6 +141,7 @@ func AIService(ctx context.Context, d *plugin.QueryData, clientType string) (*AI
 		if err != nil {
 			return nil, err
 		}
+		svc.CallOptions.ListLocations = append(svc.CallOptions.ListLocations, retry)
 		clients.Job = svc
 		return clients, nil
 	case "Model":
@@ -132,6 +149,9 @@ func AIService(ctx context.Context, d *plugin.QueryData, clientType string) (*AI
 		if err != nil {
 			return nil, err
 		}
+		svc.CallOptions.ListModels = append(svc.CallOptions.ListModels, retry)
+		svc.CallOptions.GetModel = append(svc.CallOptions.GetModel, retry)
+		svc.CallOptions.ListLocations = append(svc.CallOptions.ListLocations, retry)
 		clients.Model = svc
 		return clients, nil
 	case "Notebook":
@@ -139,6 +159,9 @@ func AIService(ctx context.Context, d *plugin.QueryData, clientType string) (*AI
 		if err != nil {
 			return nil, err
 		}
+		svc.CallOptions.ListNotebookRuntimeTemplates = append(svc.CallOptions.ListNotebookRuntimeTemplates, retry)
+		svc.CallOptions.GetNotebookRuntimeTemplate = append(svc.CallOptions.GetNotebookRuntimeTemplate, retry)
+		svc.CallOptions.ListLocations = append(svc.CallOptions.ListLocations, retry)
 		clients.Notebook = svc
 		return clients, nil
 	}
@@ -155,11 +178,8 @@ func AlloyDBService(ctx context.Context, d *plugin.QueryData) (*alloydb.Service,
 		return cachedData.(*alloydb.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := alloydb.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "alloydb", "", alloydb.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -175,11 +195,8 @@ func APIKeysService(ctx context.Context, d *plugin.QueryData) (*apikeys.Service,
 		return cachedData.(*apikeys.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := apikeys.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "apikeys", "", apikeys.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -196,11 +213,8 @@ func AppEngineService(ctx context.Context, d *plugin.QueryData) (*appengine.APIS
 		return cachedData.(*appengine.APIService), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := appengine.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "appengine", "", appengine.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -217,11 +231,8 @@ func BillingBudgetsService(ctx context.Context, d *plugin.QueryData) (*billingbu
 		return cachedData.(*billingbudgets.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := billingbudgets.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "billingbudgets", "", billingbudgets.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -238,11 +249,8 @@ func BillingService(ctx context.Context, d *plugin.QueryData) (*cloudbilling.API
 		return cachedData.(*cloudbilling.APIService), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := cloudbilling.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "cloudbilling", "", cloudbilling.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -259,11 +267,8 @@ func BigQueryService(ctx context.Context, d *plugin.QueryData) (*bigquery.Servic
 		return cachedData.(*bigquery.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := bigquery.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "bigquery", "bigquery/v2/", bigquery.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -280,11 +285,8 @@ func ArtifactRegistryService(ctx context.Context, d *plugin.QueryData) (*artifac
 		return cachedData.(*artifactregistry.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := artifactregistry.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "artifactregistry", "", artifactregistry.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -301,11 +303,8 @@ func BigtableAdminService(ctx context.Context, d *plugin.QueryData) (*bigtablead
 		return cachedData.(*bigtableadmin.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := bigtableadmin.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "bigtableadmin", "", bigtableadmin.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -322,11 +321,26 @@ func CloudResourceManagerService(ctx context.Context, d *plugin.QueryData) (*clo
 		return cachedData.(*cloudresourcemanager.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
+	// so it was not in cache - create service
+	svc, err := newRESTService(ctx, d, "cloudresourcemanager", "", cloudresourcemanager.NewService)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// CloudResourceManagerServiceV3 returns the service connection for GCP Cloud Resource Manager V3 service
+func CloudResourceManagerServiceV3(ctx context.Context, d *plugin.QueryData) (*cloudresourcemanager3.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "CloudResourceManagerServiceV3"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*cloudresourcemanager3.Service), nil
+	}
 
 	// so it was not in cache - create service
-	svc, err := cloudresourcemanager.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "cloudresourcemanager", "", cloudresourcemanager3.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -343,11 +357,8 @@ func CloudRunService(ctx context.Context, d *plugin.QueryData) (*run.Service, er
 		return cachedData.(*run.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := run.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "run", "", run.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -364,11 +375,8 @@ func CloudRunServiceV1(ctx context.Context, d *plugin.QueryData) (*run1.APIServi
 		return cachedData.(*run1.APIService), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := run1.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "run", "", run1.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -385,11 +393,8 @@ func DataplexService(ctx context.Context, d *plugin.QueryData) (*dataplex.Servic
 		return cachedData.(*dataplex.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := dataplex.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "dataplex", "", dataplex.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -406,11 +411,8 @@ func EssentialContactService(ctx context.Context, d *plugin.QueryData) (*essenti
 		return cachedData.(*essentialcontacts.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := essentialcontacts.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "essentialcontacts", "", essentialcontacts.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -427,11 +429,8 @@ func CloudSQLAdminService(ctx context.Context, d *plugin.QueryData) (*sqladmin.S
 		return cachedData.(*sqladmin.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := sqladmin.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "sqladmin", "", sqladmin.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -448,11 +447,8 @@ func ComputeBetaService(ctx context.Context, d *plugin.QueryData) (*computeBeta.
 		return cachedData.(*computeBeta.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := computeBeta.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "compute", "compute/beta/", computeBeta.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -469,11 +465,8 @@ func ComputeService(ctx context.Context, d *plugin.QueryData) (*compute.Service,
 		return cachedData.(*compute.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := compute.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "compute", "compute/v1/", compute.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -490,11 +483,8 @@ func ComposerService(ctx context.Context, d *plugin.QueryData) (*composer.Servic
 		return cachedData.(*composer.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := composer.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "composer", "", composer.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -511,11 +501,8 @@ func DataprocService(ctx context.Context, d *plugin.QueryData) (*dataproc.Servic
 		return cachedData.(*dataproc.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := dataproc.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "dataproc", "", dataproc.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -532,11 +519,8 @@ func DataprocMetastoreService(ctx context.Context, d *plugin.QueryData) (*metast
 		return cachedData.(*metastore.APIService), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := metastore.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "metastore", "", metastore.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -553,11 +537,8 @@ func ContainerService(ctx context.Context, d *plugin.QueryData) (*container.Serv
 		return cachedData.(*container.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := container.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "container", "", container.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -574,11 +555,8 @@ func CloudFunctionsService(ctx context.Context, d *plugin.QueryData) (*cloudfunc
 		return cachedData.(*cloudfunctions.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := cloudfunctions.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "cloudfunctions", "", cloudfunctions.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -595,11 +573,8 @@ func CloudIdentityService(ctx context.Context, d *plugin.QueryData) (*cloudident
 		return cachedData.(*cloudidentity.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := cloudidentity.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "cloudidentity", "", cloudidentity.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -616,11 +591,8 @@ func CloudAssetService(ctx context.Context, d *plugin.QueryData) (*cloudasset.Se
 		return cachedData.(*cloudasset.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := cloudasset.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "cloudasset", "", cloudasset.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -637,11 +609,8 @@ func DnsService(ctx context.Context, d *plugin.QueryData) (*dns.Service, error)
 		return cachedData.(*dns.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := dns.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "dns", "", dns.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -658,11 +627,8 @@ func FirestoreDatabaseService(ctx context.Context, d *plugin.QueryData) (*firest
 		return cachedData.(*firestore.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := firestore.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "firestore", "", firestore.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -679,11 +645,8 @@ func IAMService(ctx context.Context, d *plugin.QueryData) (*iam.Service, error)
 		return cachedData.(*iam.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := iam.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "iam", "", iam.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -700,11 +663,8 @@ func LoggingService(ctx context.Context, d *plugin.QueryData) (*logging.Service,
 		return cachedData.(*logging.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := logging.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "logging", "", logging.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -721,11 +681,26 @@ func MonitoringService(ctx context.Context, d *plugin.QueryData) (*monitoring.Se
 		return cachedData.(*monitoring.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
+	// so it was not in cache - create service
+	svc, err := newRESTService(ctx, d, "monitoring", "", monitoring.NewService)
+	if err != nil {
+		return nil, err
+	}
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// MonitoringServiceV1 returns the service connection for the GCP Monitoring v1 API, which serves the Prometheus HTTP API
+func MonitoringServiceV1(ctx context.Context, d *plugin.QueryData) (*monitoring1.Service, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "MonitoringServiceV1"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*monitoring1.Service), nil
+	}
 
 	// so it was not in cache - create service
-	svc, err := monitoring.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "monitoring", "", monitoring1.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -742,11 +717,8 @@ func PubsubService(ctx context.Context, d *plugin.QueryData) (*pubsub.Service, e
 		return cachedData.(*pubsub.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := pubsub.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "pubsub", "", pubsub.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -763,11 +735,8 @@ func ServiceUsageService(ctx context.Context, d *plugin.QueryData) (*serviceusag
 		return cachedData.(*serviceusage.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := serviceusage.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "serviceusage", "", serviceusage.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -784,11 +753,8 @@ func StorageService(ctx context.Context, d *plugin.QueryData) (*storage.Service,
 		return cachedData.(*storage.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := storage.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "storage", "storage/v1/", storage.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -805,11 +771,8 @@ func KMSService(ctx context.Context, d *plugin.QueryData) (*cloudkms.Service, er
 		return cachedData.(*cloudkms.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := cloudkms.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "cloudkms", "", cloudkms.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -827,7 +790,10 @@ func RedisService(ctx context.Context, d *plugin.QueryData) (*redis.CloudRedisCl
 	}
 
 	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
+	opts, err := setGRPCSessionConfig(ctx, d, "redis")
+	if err != nil {
+		return nil, err
+	}
 
 	// so it was not in cache - create service
 	svc, err := redis.NewCloudRedisClient(ctx, opts...)
@@ -835,6 +801,11 @@ func RedisService(ctx context.Context, d *plugin.QueryData) (*redis.CloudRedisCl
 		return nil, err
 	}
 
+	// retry the calls with the connection's retry policy
+	retry := grpcRetryCallOption(d.Connection)
+	svc.CallOptions.ListInstances = append(svc.CallOptions.ListInstances, retry)
+	svc.CallOptions.GetInstance = append(svc.CallOptions.GetInstance, retry)
+
 	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
 	return svc, nil
 }
@@ -848,7 +819,10 @@ func RedisClusterService(ctx context.Context, d *plugin.QueryData) (*rediscluste
 	}
 
 	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
+	opts, err := setGRPCSessionConfig(ctx, d, "redis")
+	if err != nil {
+		return nil, err
+	}
 
 	// so it was not in cache - create service
 	svc, err := rediscluster.NewCloudRedisClusterClient(ctx, opts...)
@@ -856,6 +830,38 @@ func RedisClusterService(ctx context.Context, d *plugin.QueryData) (*rediscluste
 		return nil, err
 	}
 
+	// retry the calls with the connection's retry policy
+	retry := grpcRetryCallOption(d.Connection)
+	svc.CallOptions.ListClusters = append(svc.CallOptions.ListClusters, retry)
+	svc.CallOptions.GetCluster = append(svc.CallOptions.GetCluster, retry)
+
+	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
+	return svc, nil
+}
+
+// TagBindingsService returns the service connection for GCP Resource Manager tag bindings
+func TagBindingsService(ctx context.Context, d *plugin.QueryData) (*resourcemanager.TagBindingsClient, error) {
+	// have we already created and cached the service?
+	serviceCacheKey := "TagBindingsService"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*resourcemanager.TagBindingsClient), nil
+	}
+
+	// To get config arguments from plugin config file
+	opts, err := setGRPCSessionConfig(ctx, d, "cloudresourcemanager")
+	if err != nil {
+		return nil, err
+	}
+
+	// so it was not in cache - create service
+	svc, err := resourcemanager.NewTagBindingsClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	// retry the calls with the connection's retry policy
+	svc.CallOptions.ListTagBindings = append(svc.CallOptions.ListTagBindings, grpcRetryCallOption(d.Connection))
+
 	d.ConnectionManager.Cache.Set(serviceCacheKey, svc)
 	return svc, nil
 }
@@ -867,11 +873,8 @@ func SecretManagerService(ctx context.Context, d *plugin.QueryData) (*secretmana
 		return cachedData.(*secretmanager.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := secretmanager.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "secretmanager", "", secretmanager.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -887,11 +890,8 @@ func VPCAccessService(ctx context.Context, d *plugin.QueryData) (*vpcaccess.Serv
 		return cachedData.(*vpcaccess.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := vpcaccess.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "vpcaccess", "", vpcaccess.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -908,11 +908,8 @@ func TPUService(ctx context.Context, d *plugin.QueryData) (*tpu.Service, error)
 		return cachedData.(*tpu.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := tpu.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "tpu", "", tpu.NewService)
 	if err != nil {
 		return nil, err
 	}
@@ -929,11 +926,8 @@ func WorkstationsService(ctx context.Context, d *plugin.QueryData) (*workstation
 		return cachedData.(*workstations.Service), nil
 	}
 
-	// To get config arguments from plugin config file
-	opts := setSessionConfig(ctx, d.Connection)
-
 	// so it was not in cache - create service
-	svc, err := workstations.NewService(ctx, opts...)
+	svc, err := newRESTService(ctx, d, "workstations", "", workstations.NewService)
 	if err != nil {
 		return nil, err
 	}
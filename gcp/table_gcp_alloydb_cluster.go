@@ -25,6 +25,14 @@ func tableGcpAlloyDBCluster(ctx context.Context) *plugin.Table {
 			KeyColumns: plugin.KeyColumnSlice{
 				// String columns
 				{Name: "location", Require: plugin.Optional, Operators: []string{"="}},
+				{Name: "display_name", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "state", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+
+				// Timestamp columns
+				{Name: "create_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
+
+				// JSON columns
+				{Name: "labels", Require: plugin.Optional, Operators: []string{"@>", "?"}},
 			},
 			Tags: map[string]string{"service": "alloydb", "action": "clusters.list"},
 		},
@@ -237,20 +245,32 @@ func listAlloydbClusters(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 		}
 	}
 
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
 	// Minimize the API call with given location
 	region := d.EqualsQualString("location")
 	if region != "" && region != location {
 		return nil, nil
 	}
 
-	// Get project details
-	projectId, err := getProject(ctx, d, h)
-	if err != nil {
-		return nil, err
+	// The clusters are also listed as the parent of instances, whose quals don't apply to them
+	filterString := ""
+	if d.Table.Name == "gcp_alloydb_cluster" {
+		filterQuals := []filterQualMap{
+			{"display_name", "displayName", "string"},
+			{"state", "state", "string"},
+			{"create_time", "createTime", "timestamp"},
+			{"labels", "labels", "labels"},
+		}
+		filterString = buildAIP160Filter(filterQuals, d.Quals)
 	}
-	project := projectId.(string)
 
-	resp := service.Projects.Locations.Clusters.List("projects/" + project + "/locations/" + location).PageSize(*pageSize)
+	resp := service.Projects.Locations.Clusters.List("projects/" + project + "/locations/" + location).PageSize(*pageSize).Filter(filterString)
 	if err := resp.Pages(ctx, func(page *alloydb.ListClustersResponse) error {
 		for _, cluster := range page.Clusters {
 			d.StreamListItem(ctx, cluster)
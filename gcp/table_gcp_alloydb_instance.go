@@ -26,6 +26,13 @@ func tableGcpAlloyDBInstance(ctx context.Context) *plugin.Table {
 			KeyColumns: plugin.KeyColumnSlice{
 				{Name: "location", Require: plugin.Optional, Operators: []string{"="}},
 				{Name: "cluster_name", Require: plugin.Optional, Operators: []string{"="}},
+				{Name: "state", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+
+				// Timestamp columns
+				{Name: "create_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
+
+				// JSON columns
+				{Name: "labels", Require: plugin.Optional, Operators: []string{"@>", "?"}},
 			},
 			Tags: map[string]string{"service": "alloydb", "action": "instances.list"},
 		},
@@ -239,6 +246,13 @@ func listAlloydbInstances(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 		}
 	}
 
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
 	// Minimize the API call with given location
 	region := d.EqualsQualString("location")
 	if region != "" && region != location {
@@ -250,14 +264,14 @@ func listAlloydbInstances(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 		return nil, nil
 	}
 
-	// Get project details
-	projectId, err := getProject(ctx, d, h)
-	if err != nil {
-		return nil, err
+	filterQuals := []filterQualMap{
+		{"state", "state", "string"},
+		{"create_time", "createTime", "timestamp"},
+		{"labels", "labels", "labels"},
 	}
-	project := projectId.(string)
+	filterString := buildAIP160Filter(filterQuals, d.Quals)
 
-	resp := service.Projects.Locations.Clusters.Instances.List("projects/" + project + "/locations/" + location + "/clusters/" + clusterName).PageSize(*pageSize)
+	resp := service.Projects.Locations.Clusters.Instances.List("projects/" + project + "/locations/" + location + "/clusters/" + clusterName).PageSize(*pageSize).Filter(filterString)
 	if err := resp.Pages(ctx, func(page *alloydb.ListInstancesResponse) error {
 		for _, instance := range page.Instances {
 			d.StreamListItem(ctx, instance)
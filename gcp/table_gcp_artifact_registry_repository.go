@@ -31,6 +31,16 @@ func tableGcpArtifactRegistryRepository(ctx context.Context) *plugin.Table {
 					Name:    "location",
 					Require: plugin.Optional,
 				},
+				{
+					Name:    "name",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func: getArtifactRegistryRepositoryIamPolicy,
+				Tags: map[string]string{"service": "artifactregistry", "action": "repositories.getIamPolicy"},
 			},
 		},
 		GetMatrixItemFunc: BuildArtifactRegistryLocationList,
@@ -125,6 +135,27 @@ func tableGcpArtifactRegistryRepository(ctx context.Context) *plugin.Table {
 				Hydrate:     artifactRegistryRepositorySelfLink,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "iam_policy",
+				Description: "An Identity and Access Management (IAM) policy, which specifies access controls for the repository.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getArtifactRegistryRepositoryIamPolicy,
+				Transform:   transform.FromValue(),
+			},
+			{
+				Name:        "is_public",
+				Description: "True if the IAM policy grants any role to allUsers or allAuthenticatedUsers.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getArtifactRegistryRepositoryIamPolicy,
+				Transform:   transform.FromValue().Transform(iamPolicyIsPublic),
+			},
+			{
+				Name:        "public_roles",
+				Description: "The roles the IAM policy grants to allUsers, allAuthenticatedUsers or a whole domain, with the member each is granted to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getArtifactRegistryRepositoryIamPolicy,
+				Transform:   transform.FromValue().Transform(iamPolicyPublicRoles),
+			},
 			{
 				Name:        "labels",
 				Description: "A set of labels associated with this repository.",
@@ -180,6 +211,13 @@ func listArtifactRegistryRepositories(ctx context.Context, d *plugin.QueryData,
 		location = matrixLocation
 	}
 
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
 	// Minimize API call for given location
 	if region != "" && region != location {
 		return nil, nil
@@ -202,17 +240,15 @@ func listArtifactRegistryRepositories(ctx context.Context, d *plugin.QueryData,
 		}
 	}
 
-	// Get project details
+	data := "projects/" + project + "/locations/" + location
 
-	projectId, err := getProject(ctx, d, h)
-	if err != nil {
-		return nil, err
+	// The API only filters on the full resource name, i.e. name="projects/p/locations/l/repositories/r"
+	filterString := ""
+	if name := d.EqualsQualString("name"); name != "" {
+		filterString = "name = " + quoteFilterValue(data+"/repositories/"+name)
 	}
-	project := projectId.(string)
 
-	data := "projects/" + project + "/locations/" + location
-
-	resp := service.Projects.Locations.Repositories.List(data).PageSize(*pageSize)
+	resp := service.Projects.Locations.Repositories.List(data).PageSize(*pageSize).Filter(filterString)
 	if err := resp.Pages(ctx, func(page *artifactregistry.ListRepositoriesResponse) error {
 		// apply rate limiting
 		d.WaitForListRateLimit(ctx)
@@ -271,6 +307,25 @@ func getArtifactRegistryRepository(ctx context.Context, d *plugin.QueryData, h *
 	return resp, nil
 }
 
+func getArtifactRegistryRepositoryIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	repository := h.Item.(*artifactregistry.Repository)
+
+	// Create Service Connection
+	service, err := ArtifactRegistryService(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_artifact_registry_repository.getArtifactRegistryRepositoryIamPolicy", "service_error", err)
+		return nil, err
+	}
+
+	resp, err := service.Projects.Locations.Repositories.GetIamPolicy(repository.Name).OptionsRequestedPolicyVersion(3).Do()
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_artifact_registry_repository.getArtifactRegistryRepositoryIamPolicy", "api_error", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 func artifactRegistryRepositorySelfLink(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	data := h.Item.(*artifactregistry.Repository)
 
@@ -113,6 +113,20 @@ func tableGcpBigQueryDataset(ctx context.Context) *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Hydrate:     getBigQueryDataset,
 			},
+			{
+				Name:        "is_public",
+				Description: "True if the access entries of the dataset grant any role to allUsers or allAuthenticatedUsers.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getBigQueryDataset,
+				Transform:   transform.FromField("Access").Transform(bigQueryDatasetIsPublic),
+			},
+			{
+				Name:        "public_roles",
+				Description: "The roles the access entries of the dataset grant to allUsers, allAuthenticatedUsers or a whole domain, with the member each is granted to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getBigQueryDataset,
+				Transform:   transform.FromField("Access").Transform(bigQueryDatasetPublicRoles),
+			},
 			{
 				Name:        "labels",
 				Description: "A set of labels associated with this dataset.",
@@ -0,0 +1,158 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/cloudasset/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCloudAssetIamPolicySearch(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_cloud_asset_iam_policy_search",
+		Description: "GCP Cloud Asset IAM Policy Search",
+		List: &plugin.ListConfig{
+			Hydrate: listCloudAssetIamPolicySearch,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "scope", Require: plugin.Optional, Operators: []string{"="}},
+				{Name: "query", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "asset_types", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+			Tags: map[string]string{"service": "cloudasset", "action": "v1.searchAllIamPolicies"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "resource",
+				Description: "The full resource name of the resource the IAM policy is set on, e.g. //cloudresourcemanager.googleapis.com/projects/my_project_123.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "asset_type",
+				Description: "The type of the resource the IAM policy is set on, e.g. cloudresourcemanager.googleapis.com/Project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "project_number",
+				Description: "The number of the project the resource belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Project").Transform(lastPathElement),
+			},
+			{
+				Name:        "folders",
+				Description: "The folders the resource belongs to, e.g. [\"folders/123\"].",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "organization",
+				Description: "The organization the resource belongs to, e.g. organizations/123.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy",
+				Description: "The IAM policy directly set on the resource. When the query names a member or permission, only the matching bindings are returned.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "explanation",
+				Description: "The permissions matched by the query in each binding, for queries that search by permission.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "scope",
+				Description: "The organization, folder or project searched, e.g. organizations/123. Defaults to the connection's organization, else each of its folders, else its project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "query",
+				Description: "The Cloud Asset IAM policy search query, e.g. policy:user:alice@example.com or policy.role.permissions:storage.buckets.delete.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("query"),
+			},
+			{
+				Name:        "asset_types",
+				Description: "A JSON array of the asset types searched, e.g. [\"storage.googleapis.com/Bucket\"]. Defaults to all searchable types.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromQual("asset_types"),
+			},
+
+			// Standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource"),
+			},
+		},
+	}
+}
+
+type cloudAssetIamPolicySearchResult struct {
+	*cloudasset.IamPolicySearchResult
+	Scope string
+}
+
+//// LIST FUNCTION
+
+func listCloudAssetIamPolicySearch(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	// Create Service Connection
+	service, err := CloudAssetService(ctx, d)
+	if err != nil {
+		logger.Error("gcp_cloud_asset_iam_policy_search.listCloudAssetIamPolicySearch", "service_error", err)
+		return nil, err
+	}
+
+	scopes, err := getCloudAssetSearchScopes(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	assetTypes, err := getCloudAssetSearchAssetTypes(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	for _, scope := range scopes {
+		resp := service.V1.SearchAllIamPolicies(scope).Query(d.EqualsQualString("query")).AssetTypes(assetTypes...).PageSize(*pageSize)
+		if err := resp.Pages(ctx, func(page *cloudasset.SearchAllIamPoliciesResponse) error {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			for _, item := range page.Results {
+				d.StreamListItem(ctx, &cloudAssetIamPolicySearchResult{item, scope})
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+			return nil
+		}); err != nil {
+			logger.Error("gcp_cloud_asset_iam_policy_search.listCloudAssetIamPolicySearch", "api_error", err)
+			return nil, err
+		}
+
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	return nil, nil
+}
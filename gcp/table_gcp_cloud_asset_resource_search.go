@@ -0,0 +1,263 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/cloudasset/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCloudAssetResourceSearch(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_cloud_asset_resource_search",
+		Description: "GCP Cloud Asset Resource Search",
+		List: &plugin.ListConfig{
+			Hydrate: listCloudAssetResourceSearch,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "scope", Require: plugin.Optional, Operators: []string{"="}},
+				{Name: "query", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "asset_types", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+			Tags: map[string]string{"service": "cloudasset", "action": "v1.searchAllResources"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The full resource name of the resource, e.g. //compute.googleapis.com/projects/my_project_123/zones/zone1/instances/instance1.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "asset_type",
+				Description: "The type of the resource, e.g. compute.googleapis.com/Disk.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the resource, e.g. RUNNING for a Compute Engine instance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "create_time",
+				Description: "The create timestamp of the resource, if the resource type supports it.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "update_time",
+				Description: "The last update timestamp of the resource, if the resource type supports it.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "project_number",
+				Description: "The number of the project the resource belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Project").Transform(lastPathElement),
+			},
+			{
+				Name:        "folders",
+				Description: "The folders the resource belongs to, e.g. [\"folders/123\"].",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "organization",
+				Description: "The organization the resource belongs to, e.g. organizations/123.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent_full_resource_name",
+				Description: "The full resource name of the parent of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "parent_asset_type",
+				Description: "The type of the parent of the resource.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "additional_attributes",
+				Description: "The additional searchable attributes of the resource, which vary by resource type, e.g. projectId for a project.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "kms_keys",
+				Description: "The Cloud KMS keys used to encrypt the resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "network_tags",
+				Description: "The network tags of the resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resource_tags",
+				Description: "The Resource Manager tags directly attached to the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Tags"),
+			},
+			{
+				Name:        "labels",
+				Description: "The labels of the resource.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "scope",
+				Description: "The organization, folder or project searched, e.g. organizations/123. Defaults to the connection's organization, else each of its folders, else its project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "query",
+				Description: "The Cloud Asset search query, e.g. state:RUNNING or labels.env:prod.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("query"),
+			},
+			{
+				Name:        "asset_types",
+				Description: "A JSON array of the asset types searched, e.g. [\"compute.googleapis.com/Instance\"]. Defaults to all searchable types.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromQual("asset_types"),
+			},
+
+			// Standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+
+			// Standard GCP columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+			},
+		},
+	}
+}
+
+type cloudAssetResourceSearchResult struct {
+	*cloudasset.ResourceSearchResult
+	Scope string
+}
+
+//// LIST FUNCTION
+
+func listCloudAssetResourceSearch(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	// Create Service Connection
+	service, err := CloudAssetService(ctx, d)
+	if err != nil {
+		logger.Error("gcp_cloud_asset_resource_search.listCloudAssetResourceSearch", "service_error", err)
+		return nil, err
+	}
+
+	scopes, err := getCloudAssetSearchScopes(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	assetTypes, err := getCloudAssetSearchAssetTypes(d)
+	if err != nil {
+		return nil, err
+	}
+
+	// Max limit is set as per documentation
+	pageSize := types.Int64(500)
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < *pageSize {
+			pageSize = limit
+		}
+	}
+
+	for _, scope := range scopes {
+		resp := service.V1.SearchAllResources(scope).Query(d.EqualsQualString("query")).AssetTypes(assetTypes...).PageSize(*pageSize)
+		if err := resp.Pages(ctx, func(page *cloudasset.SearchAllResourcesResponse) error {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			for _, item := range page.Results {
+				d.StreamListItem(ctx, &cloudAssetResourceSearchResult{item, scope})
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+			return nil
+		}); err != nil {
+			logger.Error("gcp_cloud_asset_resource_search.listCloudAssetResourceSearch", "api_error", err)
+			return nil, err
+		}
+
+		if d.RowsRemaining(ctx) == 0 {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+// getCloudAssetSearchScopes returns the organizations, folders or projects to search, from the
+// `scope` qual. Without one the connection's organization is searched, or else its folders, or
+// else its project.
+func getCloudAssetSearchScopes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) ([]string, error) {
+	if scope := d.EqualsQualString("scope"); scope != "" {
+		return []string{scope}, nil
+	}
+
+	gcpConfig := GetConfig(d.Connection)
+	if gcpConfig.Organization != nil {
+		return []string{"organizations/" + strings.TrimPrefix(*gcpConfig.Organization, "organizations/")}, nil
+	}
+	if len(gcpConfig.Folders) > 0 {
+		var scopes []string
+		for _, folder := range gcpConfig.Folders {
+			scopes = append(scopes, "folders/"+strings.TrimPrefix(folder, "folders/"))
+		}
+		return scopes, nil
+	}
+
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	return []string{"projects/" + projectId.(string)}, nil
+}
+
+// getCloudAssetSearchAssetTypes returns the asset types to search, from the `asset_types` qual
+func getCloudAssetSearchAssetTypes(d *plugin.QueryData) ([]string, error) {
+	var assetTypes []string
+	if d.EqualsQuals["asset_types"] != nil {
+		if err := json.Unmarshal([]byte(d.EqualsQuals["asset_types"].GetJsonbValue()), &assetTypes); err != nil {
+			return nil, fmt.Errorf("asset_types must be a JSON array of strings, e.g. '[\"compute.googleapis.com/Instance\"]': %v", err)
+		}
+	}
+	return assetTypes, nil
+}
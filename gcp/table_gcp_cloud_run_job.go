@@ -237,6 +237,13 @@ func listCloudRunJobs(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 		location = matrixLocation
 	}
 
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
 	// Minimize API call as per given location
 	if region != "" && region != location {
 		return nil, nil
@@ -258,14 +265,6 @@ func listCloudRunJobs(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 		}
 	}
 
-	// Get project details
-
-	projectId, err := getProject(ctx, d, h)
-	if err != nil {
-		return nil, err
-	}
-	project := projectId.(string)
-
 	input := "projects/" + project + "/locations/" + location
 
 	resp := service.Projects.Locations.Jobs.List(input).PageSize(*pageSize)
@@ -217,6 +217,20 @@ func tableGcpCloudRunService(ctx context.Context) *plugin.Table {
 				Hydrate:     getCloudRunServiceIamPolicy,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "is_public",
+				Description: "True if the IAM policy grants any role to allUsers or allAuthenticatedUsers.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getCloudRunServiceIamPolicy,
+				Transform:   transform.FromValue().Transform(iamPolicyIsPublic),
+			},
+			{
+				Name:        "public_roles",
+				Description: "The roles the IAM policy grants to allUsers, allAuthenticatedUsers or a whole domain, with the member each is granted to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCloudRunServiceIamPolicy,
+				Transform:   transform.FromValue().Transform(iamPolicyPublicRoles),
+			},
 
 			// Standard steampipe columns
 			{
@@ -267,6 +281,13 @@ func listCloudRunServices(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 		location = matrixLocation
 	}
 
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
 	// Minimize API call as per given location
 	if region != "" && region != location {
 		return nil, nil
@@ -288,14 +309,6 @@ func listCloudRunServices(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 		}
 	}
 
-	// Get project details
-
-	projectId, err := getProject(ctx, d, h)
-	if err != nil {
-		return nil, err
-	}
-	project := projectId.(string)
-
 	input := "projects/" + project + "/locations/" + location
 
 	resp := service.Projects.Locations.Services.List(input).PageSize(*pageSize)
@@ -381,7 +394,7 @@ func getCloudRunServiceIamPolicy(ctx context.Context, d *plugin.QueryData, h *pl
 
 	input := "projects/" + project + "/locations/" + location + "/services/" + serviceName
 
-	resp, err := service.Projects.Locations.Services.GetIamPolicy(input).Do()
+	resp, err := service.Projects.Locations.Services.GetIamPolicy(input).OptionsRequestedPolicyVersion(3).Do()
 	if err != nil {
 		plugin.Logger(ctx).Error("gcp_cloud_run_service.getCloudRunServiceIamPolicy", "api_error", err)
 		return nil, err
@@ -0,0 +1,50 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/run/v2"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpCloudRunServiceMetricRequestCountDaily(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_cloud_run_service_metric_request_count_daily",
+		Description: "GCP Cloud Run Service Metrics - Request Count (Daily)",
+		List: &plugin.ListConfig{
+			ParentHydrate: listCloudRunServices,
+			Hydrate:       listCloudRunServiceMetricRequestCountDaily,
+			KeyColumns:    monitoringMetricKeyColumns(nil),
+			Tags:          map[string]string{"service": "monitoring", "action": "timeSeries.list"},
+		},
+		GetMatrixItemFunc: BuildCloudRunLocationList,
+		Columns: monitoringMetricColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the service.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DimensionValue"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listCloudRunServiceMetricRequestCountDaily(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	serviceInfo := h.Item.(*run.GoogleCloudRunV2Service)
+
+	serviceName := getLastPathElement(serviceInfo.Name)
+	location := strings.Split(serviceInfo.Name, "/")[3]
+	dimensionValue := "\"" + serviceName + "\""
+
+	// Service names are only unique within a location
+	dimensionKey := "resource.label.location = \"" + location + "\" AND resource.label.service_name = "
+
+	return listMonitorMetricStatistics(ctx, d, h, "DAILY", "\"run.googleapis.com/request_count\"", dimensionKey, dimensionValue, serviceName, location)
+}
@@ -102,6 +102,20 @@ func tableGcpCloudfunctionFunction(ctx context.Context) *plugin.Table {
 				Description: "The IAM policy for the function.", Transform: transform.FromValue(), Hydrate: getGcpCloudFunctionIamPolicy,
 				Type: proto.ColumnType_JSON,
 			},
+			{
+				Name:        "is_public",
+				Description: "True if the IAM policy grants any role to allUsers or allAuthenticatedUsers.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getGcpCloudFunctionIamPolicy,
+				Transform:   transform.FromValue().Transform(iamPolicyIsPublic),
+			},
+			{
+				Name:        "public_roles",
+				Description: "The roles the IAM policy grants to allUsers, allAuthenticatedUsers or a whole domain, with the member each is granted to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGcpCloudFunctionIamPolicy,
+				Transform:   transform.FromValue().Transform(iamPolicyPublicRoles),
+			},
 			{
 				Name:        "ingress_settings",
 				Description: "The ingress settings for the function, controlling what traffic can reach it (INGRESS_SETTINGS_UNSPECIFIED, ALLOW_ALL, ALLOW_INTERNAL_ONLY, ALLOW_INTERNAL_AND_GCLB).",
@@ -391,7 +405,7 @@ func getGcpCloudFunctionIamPolicy(ctx context.Context, d *plugin.QueryData, h *p
 
 	function := h.Item.(*cloudfunctions.Function)
 
-	resp, err := service.Projects.Locations.Functions.GetIamPolicy(function.Name).Do()
+	resp, err := service.Projects.Locations.Functions.GetIamPolicy(function.Name).OptionsRequestedPolicyVersion(3).Do()
 	if err != nil {
 		return nil, err
 	}
@@ -281,6 +281,10 @@ func getComposerEnvironment(ctx context.Context, d *plugin.QueryData, h *plugin.
 		return nil, nil
 	}
 
+	if err := getMatrixLocationError(ctx, d); err != nil {
+		return nil, err
+	}
+
 	// Restrict API call for other location
 	if len(strings.Split(name, "/")) > 3 && strings.Split(name, "/")[3] != location {
 		return nil, nil
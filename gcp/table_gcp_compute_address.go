@@ -10,6 +10,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 //// TABLE DEFINITION
@@ -19,9 +20,12 @@ func tableGcpComputeAddress(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_address",
 		Description: "GCP Compute Address",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeAddress,
-			Tags:       map[string]string{"service": "compute", "action": "addresses.get"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeAddress,
+			Tags:    map[string]string{"service": "compute", "action": "addresses.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeAddresses,
@@ -231,7 +235,6 @@ func getComputeAddress(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	}
 	project := projectId.(string)
 
-	var address compute.Address
 	name := d.EqualsQuals["name"].GetStringValue()
 
 	// Empty check
@@ -239,27 +242,36 @@ func getComputeAddress(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 		return nil, nil
 	}
 
-	resp := service.Addresses.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(
-		ctx,
-		func(page *compute.AddressAggregatedList) error {
-			for _, item := range page.Items {
-				for _, i := range item.Addresses {
-					address = *i
-				}
-			}
-			return nil
-		},
-	); err != nil {
+	address, err := getComputeResource(ctx, d, project, computeAddressType, computeAddressAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "regions":
+			return service.Addresses.Get(project, location, name).Do()
+		case "global":
+			return service.GlobalAddresses.Get(project, name).Do()
+		}
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || address == nil {
 		return nil, err
 	}
 
-	// If the specified resource is not present, API does not return any not found errors
-	if len(address.Name) < 1 {
-		return nil, nil
-	}
+	return address.(*compute.Address), nil
+}
 
-	return &address, nil
+// computeAddressAggregatedList lists the addresses of a project for getComputeResource
+func computeAddressAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.Addresses.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.AddressAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, address := range list.Addresses {
+					fn(scope, address.Name, address)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 //// TRANSFORM FUNCTIONS
@@ -9,6 +9,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 func tableGcpComputeAutoscaler(ctx context.Context) *plugin.Table {
@@ -16,9 +17,12 @@ func tableGcpComputeAutoscaler(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_autoscaler",
 		Description: "GCP Compute Autoscaler",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeAutoscaler,
-			Tags:       map[string]string{"service": "compute", "action": "autoscalers.get"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeAutoscaler,
+			Tags:    map[string]string{"service": "compute", "action": "autoscalers.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeAutoscaler,
@@ -211,7 +215,6 @@ func getComputeAutoscaler(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 	}
 	project := projectId.(string)
 
-	var autoscaler compute.Autoscaler
 	name := d.EqualsQuals["name"].GetStringValue()
 	if name == "" {
 		return nil, nil
@@ -224,26 +227,36 @@ func getComputeAutoscaler(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 		return nil, err
 	}
 
-	resp := service.Autoscalers.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(ctx, func(page *compute.AutoscalerAggregatedList) error {
-		for _, item := range page.Items {
-			for _, i := range item.Autoscalers {
-				autoscaler = *i
-			}
+	autoscaler, err := getComputeResource(ctx, d, project, computeAutoscalerType, computeAutoscalerAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "zones":
+			return service.Autoscalers.Get(project, location, name).Do()
+		case "regions":
+			return service.RegionAutoscalers.Get(project, location, name).Do()
 		}
-		return nil
-	},
-	); err != nil {
-		plugin.Logger(ctx).Error("gcp_compute_autoscaler.getComputeAutoscaler", "api_err", err)
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || autoscaler == nil {
 		return nil, err
 	}
 
-	// If the specified resource is not present, API does not return any not found errors
-	if len(autoscaler.Name) < 1 {
-		return nil, nil
-	}
+	return autoscaler.(*compute.Autoscaler), nil
+}
 
-	return &autoscaler, nil
+// computeAutoscalerAggregatedList lists the autoscalers of a project for getComputeResource
+func computeAutoscalerAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.Autoscalers.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.AutoscalerAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, autoscaler := range list.Autoscalers {
+					fn(scope, autoscaler.Name, autoscaler)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 //// TRANSFORM FUNCTIONS
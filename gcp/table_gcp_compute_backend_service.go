@@ -10,6 +10,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 //// TABLE DEFINITION
@@ -19,9 +20,12 @@ func tableGcpComputeBackendService(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_backend_service",
 		Description: "GCP Compute Backend Service",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeBackendService,
-			Tags:       map[string]string{"service": "compute", "action": "backendServices.get"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeBackendService,
+			Tags:    map[string]string{"service": "compute", "action": "backendServices.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeBackendServices,
@@ -322,30 +326,38 @@ func getComputeBackendService(ctx context.Context, d *plugin.QueryData, h *plugi
 	}
 	project := projectId.(string)
 
-	var backendService compute.BackendService
 	name := d.EqualsQuals["name"].GetStringValue()
 
-	resp := service.BackendServices.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(
-		ctx,
-		func(page *compute.BackendServiceAggregatedList) error {
-			for _, item := range page.Items {
-				for _, i := range item.BackendServices {
-					backendService = *i
-				}
-			}
-			return nil
-		},
-	); err != nil {
+	backendService, err := getComputeResource(ctx, d, project, computeBackendServiceType, computeBackendServiceAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "regions":
+			return service.RegionBackendServices.Get(project, location, name).Do()
+		case "global":
+			return service.BackendServices.Get(project, name).Do()
+		}
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || backendService == nil {
 		return nil, err
 	}
 
-	// If the specified resource is not present, API does not return any not found errors
-	if len(backendService.Name) < 1 {
-		return nil, nil
-	}
+	return backendService.(*compute.BackendService), nil
+}
 
-	return &backendService, nil
+// computeBackendServiceAggregatedList lists the backend services of a project for getComputeResource
+func computeBackendServiceAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.BackendServices.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.BackendServiceAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, backendService := range list.BackendServices {
+					fn(scope, backendService.Name, backendService)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 //// TRANSFORM FUNCTIONS
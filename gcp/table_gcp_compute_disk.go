@@ -9,6 +9,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 func tableGcpComputeDisk(ctx context.Context) *plugin.Table {
@@ -16,16 +17,28 @@ func tableGcpComputeDisk(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_disk",
 		Description: "GCP Compute Disk",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeDisk,
-			Tags:       map[string]string{"service": "compute", "action": "disks.get"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeDisk,
+			Tags:    map[string]string{"service": "compute", "action": "disks.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeDisk,
 			KeyColumns: plugin.KeyColumnSlice{
 				// String columns
-				{Name: "name", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				{Name: "name", Require: plugin.Optional, Operators: []string{"<>", "=", "~~"}},
 				{Name: "status", Require: plugin.Optional, Operators: []string{"<>", "="}},
+
+				// Numeric columns
+				{Name: "size_gb", Require: plugin.Optional, Operators: []string{"<>", "=", ">", ">=", "<", "<="}},
+
+				// Timestamp columns, only lower bounds are pushed down
+				{Name: "creation_timestamp", Require: plugin.Optional, Operators: []string{">", ">="}},
+
+				// JSON columns
+				{Name: "labels", Require: plugin.Optional, Operators: []string{"@>", "?"}},
 			},
 			Tags: map[string]string{"service": "compute", "action": "disks.list"},
 		},
@@ -281,6 +294,9 @@ func listComputeDisk(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 	filterQuals := []filterQualMap{
 		{"name", "name", "string"},
 		{"status", "status", "string"},
+		{"size_gb", "sizeGb", "int64"},
+		{"creation_timestamp", "creationTimestamp", "timestamp"},
+		{"labels", "labels", "labels"},
 	}
 
 	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
@@ -351,28 +367,38 @@ func getComputeDisk(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 	}
 	project := projectId.(string)
 
-	var disk compute.Disk
 	name := d.EqualsQuals["name"].GetStringValue()
 
-	resp := service.Disks.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(ctx, func(page *compute.DiskAggregatedList) error {
-		for _, item := range page.Items {
-			for _, i := range item.Disks {
-				disk = *i
-			}
+	disk, err := getComputeResource(ctx, d, project, computeDiskType, computeDiskAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "zones":
+			return service.Disks.Get(project, location, name).Do()
+		case "regions":
+			return service.RegionDisks.Get(project, location, name).Do()
 		}
-		return nil
-	},
-	); err != nil {
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || disk == nil {
 		return nil, err
 	}
 
-	// If the specified resource is not present, API does not return any not found errors
-	if len(disk.Name) < 1 {
-		return nil, nil
-	}
+	return disk.(*compute.Disk), nil
+}
 
-	return &disk, nil
+// computeDiskAggregatedList lists the disks of a project for getComputeResource
+func computeDiskAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.Disks.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.DiskAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, disk := range list.Disks {
+					fn(scope, disk.Name, disk)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 func getComputeDiskIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -392,7 +418,7 @@ func getComputeDiskIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin
 	if zoneName == "" {
 		regionName := getLastPathElement(types.SafeString(disk.Region))
 		// regional disk get iam policy
-		resp, err = service.RegionDisks.GetIamPolicy(project, regionName, disk.Name).Do()
+		resp, err = service.RegionDisks.GetIamPolicy(project, regionName, disk.Name).OptionsRequestedPolicyVersion(3).Do()
 		if err != nil {
 			return nil, err
 		}
@@ -400,7 +426,7 @@ func getComputeDiskIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin
 	}
 
 	// zonal disk get iam policy
-	resp, err = service.Disks.GetIamPolicy(project, zoneName, disk.Name).Do()
+	resp, err = service.Disks.GetIamPolicy(project, zoneName, disk.Name).OptionsRequestedPolicyVersion(3).Do()
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,58 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeDiskIamBinding(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_disk_iam_binding",
+		Description: "GCP Compute Disk IAM Binding",
+		List: &plugin.ListConfig{
+			ParentHydrate: listComputeDisk,
+			Hydrate:       listComputeDiskIamBindings,
+			Tags:          map[string]string{"service": "compute", "action": "disks.getIamPolicy"},
+		},
+		Columns: iamBindingColumns([]*plugin.Column{
+			{
+				Name:        "disk_name",
+				Description: "The name of the disk.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeDiskIamBindings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	disk := h.Item.(*compute.Disk)
+
+	// Disks are either zonal or regional
+	location := getLastPathElement(disk.Zone)
+	if location == "" {
+		location = getLastPathElement(disk.Region)
+	}
+
+	// apply rate limiting
+	d.WaitForListRateLimit(ctx)
+
+	policy, err := getComputeDiskIamPolicy(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_disk_iam_binding.listComputeDiskIamBindings", "api_error", err)
+		return nil, err
+	}
+
+	_, err = streamIamPolicyBindings(ctx, d, policy, disk.Name, location, strings.Split(disk.SelfLink, "/")[6])
+	return nil, err
+}
@@ -19,7 +19,7 @@ func tableGcpComputeDiskMetricReadOpsHourly(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			ParentHydrate: listComputeDisk,
 			Hydrate:       listComputeDiskMetricReadOpsHourly,
-			KeyColumns:    plugin.OptionalColumns([]string{"name"}),
+			KeyColumns:    monitoringMetricKeyColumns(plugin.OptionalColumns([]string{"name"})),
 			Tags:          map[string]string{"service": "monitoring", "action": "timeSeries.list"},
 		},
 		Columns: monitoringMetricColumns([]*plugin.Column{
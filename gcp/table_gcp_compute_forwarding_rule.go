@@ -10,6 +10,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
 	compute "google.golang.org/api/compute/v0.beta"
+	"google.golang.org/api/googleapi"
 )
 
 //// TABLE DEFINITION
@@ -19,9 +20,12 @@ func tableGcpComputeForwardingRule(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_forwarding_rule",
 		Description: "GCP Compute Forwarding Rule",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeForwardingRule,
-			Tags:       map[string]string{"service": "compute", "action": "forwardingRules.get"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeForwardingRule,
+			Tags:    map[string]string{"service": "compute", "action": "forwardingRules.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeForwardingRules,
@@ -294,30 +298,38 @@ func getComputeForwardingRule(ctx context.Context, d *plugin.QueryData, h *plugi
 	}
 	project := projectId.(string)
 
-	var forwardingRule compute.ForwardingRule
 	name := d.EqualsQuals["name"].GetStringValue()
 
-	resp := service.ForwardingRules.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(
-		ctx,
-		func(page *compute.ForwardingRuleAggregatedList) error {
-			for _, item := range page.Items {
-				for _, i := range item.ForwardingRules {
-					forwardingRule = *i
-				}
-			}
-			return nil
-		},
-	); err != nil {
+	forwardingRule, err := getComputeResource(ctx, d, project, computeForwardingRuleType, computeForwardingRuleAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "regions":
+			return service.ForwardingRules.Get(project, location, name).Do()
+		case "global":
+			return service.GlobalForwardingRules.Get(project, name).Do()
+		}
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || forwardingRule == nil {
 		return nil, err
 	}
 
-	// If the specified resource is not present, API does not return any not found errors
-	if len(forwardingRule.Name) < 1 {
-		return nil, nil
-	}
+	return forwardingRule.(*compute.ForwardingRule), nil
+}
 
-	return &forwardingRule, nil
+// computeForwardingRuleAggregatedList lists the forwarding rules of a project for getComputeResource
+func computeForwardingRuleAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.ForwardingRules.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.ForwardingRuleAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, forwardingRule := range list.ForwardingRules {
+					fn(scope, forwardingRule.Name, forwardingRule)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 //// TRANSFORM FUNCTIONS
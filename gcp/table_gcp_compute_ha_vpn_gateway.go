@@ -9,6 +9,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 //// TABLE DEFINITION
@@ -18,9 +19,12 @@ func tableGcpComputeHaVpnGateway(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_ha_vpn_gateway",
 		Description: "GCP Compute VPN Gateway",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeHaVpnGateway,
-			Tags:       map[string]string{"service": "compute", "action": "vpnGateways.get"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeHaVpnGateway,
+			Tags:    map[string]string{"service": "compute", "action": "vpnGateways.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeHaVpnGateways,
@@ -206,10 +210,9 @@ func getComputeHaVpnGateway(ctx context.Context, d *plugin.QueryData, h *plugin.
 	}
 	project := projectId.(string)
 
-	var vpnGateway compute.VpnGateway
 	name := d.EqualsQuals["name"].GetStringValue()
 	// Empty check
-	if name != "" {
+	if name == "" {
 		return nil, nil
 	}
 
@@ -220,28 +223,34 @@ func getComputeHaVpnGateway(ctx context.Context, d *plugin.QueryData, h *plugin.
 		return nil, err
 	}
 
-	resp := service.VpnGateways.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(
-		ctx,
-		func(page *compute.VpnGatewayAggregatedList) error {
-			for _, item := range page.Items {
-				for _, i := range item.VpnGateways {
-					vpnGateway = *i
-				}
-			}
-			return nil
-		},
-	); err != nil {
-		plugin.Logger(ctx).Error("gcp_compute_ha_vpn_gateway.getComputeHaVpnGateway", "api_err", err)
+	vpnGateway, err := getComputeResource(ctx, d, project, computeHaVpnGatewayType, computeHaVpnGatewayAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "regions":
+			return service.VpnGateways.Get(project, location, name).Do()
+		}
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || vpnGateway == nil {
 		return nil, err
 	}
 
-	// If the specified resource is not present, API does not return any not found errors
-	if len(vpnGateway.Name) < 1 {
-		return nil, nil
-	}
+	return vpnGateway.(*compute.VpnGateway), nil
+}
 
-	return &vpnGateway, nil
+// computeHaVpnGatewayAggregatedList lists the HA VPN gateways of a project for getComputeResource
+func computeHaVpnGatewayAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.VpnGateways.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.VpnGatewayAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, vpnGateway := range list.VpnGateways {
+					fn(scope, vpnGateway.Name, vpnGateway)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 func getComputeHaVpnGatewayVpnConnections(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -414,7 +414,7 @@ func getComputeImageIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugi
 		return nil, nil
 	}
 
-	resp, err := service.Images.GetIamPolicy(project, image.Name).Do()
+	resp, err := service.Images.GetIamPolicy(project, image.Name).OptionsRequestedPolicyVersion(3).Do()
 	if err != nil {
 		return err, nil
 	}
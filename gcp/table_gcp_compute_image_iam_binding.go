@@ -0,0 +1,82 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeImageIamBinding(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_image_iam_binding",
+		Description: "GCP Compute Image IAM Binding",
+		List: &plugin.ListConfig{
+			Hydrate: listComputeImageIamBindings,
+			Tags:    map[string]string{"service": "compute", "action": "images.getIamPolicy"},
+		},
+		Columns: iamBindingColumns([]*plugin.Column{
+			{
+				Name:        "image_name",
+				Description: "The name of the image.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+// listComputeImageIamBindings lists the bindings of the project's own images only, since the
+// IAM policies of public images in other projects are not readable.
+func listComputeImageIamBindings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	// Create Service Connection
+	service, err := ComputeService(ctx, d)
+	if err != nil {
+		logger.Error("gcp_compute_image_iam_binding.listComputeImageIamBindings", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	resp := service.Images.List(project)
+	if err := resp.Pages(ctx, func(page *compute.ImageList) error {
+		for _, image := range page.Items {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			policy, err := service.Images.GetIamPolicy(project, image.Name).OptionsRequestedPolicyVersion(3).Context(ctx).Do()
+			if err != nil {
+				return err
+			}
+
+			more, err := streamIamPolicyBindings(ctx, d, policy, image.Name, "global", project)
+			if err != nil {
+				return err
+			}
+			if !more {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		logger.Error("gcp_compute_image_iam_binding.listComputeImageIamBindings", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 func tableGcpComputeInstance(ctx context.Context) *plugin.Table {
@@ -18,15 +19,18 @@ func tableGcpComputeInstance(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_instance",
 		Description: "GCP Compute Instance",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeInstance,
-			Tags: map[string]string{"service": "compute", "action": "instances.get"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeInstance,
+			Tags:    map[string]string{"service": "compute", "action": "instances.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeInstances,
 			KeyColumns: plugin.KeyColumnSlice{
 				// String columns
-				{Name: "name", Require: plugin.Optional, Operators: []string{"<>", "="}},
+				{Name: "name", Require: plugin.Optional, Operators: []string{"<>", "=", "~~"}},
 				{Name: "cpu_platform", Require: plugin.Optional, Operators: []string{"<>", "="}},
 				{Name: "hostname", Require: plugin.Optional, Operators: []string{"<>", "="}},
 				{Name: "status", Require: plugin.Optional, Operators: []string{"<>", "="}},
@@ -35,6 +39,12 @@ func tableGcpComputeInstance(ctx context.Context) *plugin.Table {
 				{Name: "can_ip_forward", Require: plugin.Optional, Operators: []string{"<>", "="}},
 				{Name: "deletion_protection", Require: plugin.Optional, Operators: []string{"<>", "="}},
 				{Name: "start_restricted", Require: plugin.Optional, Operators: []string{"<>", "="}},
+
+				// Timestamp columns, only lower bounds are pushed down
+				{Name: "creation_timestamp", Require: plugin.Optional, Operators: []string{">", ">="}},
+
+				// JSON columns
+				{Name: "labels", Require: plugin.Optional, Operators: []string{"@>", "?"}},
 			},
 			Tags: map[string]string{"service": "compute", "action": "instances.list"},
 		},
@@ -43,7 +53,6 @@ func tableGcpComputeInstance(ctx context.Context) *plugin.Table {
 				Func: getComputeInstanceIamPolicy,
 				Tags: map[string]string{"service": "compute", "action": "instances.getIamPolicy"},
 			},
-			
 		},
 		Columns: []*plugin.Column{
 			// commonly used columns
@@ -309,6 +318,8 @@ func listComputeInstances(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 		{"can_ip_forward", "canIpForward", "boolean"},
 		{"deletion_protection", "deletionProtection", "boolean"},
 		{"start_restricted", "startRestricted", "boolean"},
+		{"creation_timestamp", "creationTimestamp", "timestamp"},
+		{"labels", "labels", "labels"},
 	}
 
 	filters := buildQueryFilterFromQuals(filterQuals, d.Quals)
@@ -379,30 +390,36 @@ func getComputeInstance(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	}
 	project := projectId.(string)
 
-	var instance compute.Instance
 	name := d.EqualsQuals["name"].GetStringValue()
 
-	resp := service.Instances.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(
-		ctx,
-		func(page *compute.InstanceAggregatedList) error {
-			for _, item := range page.Items {
-				for _, i := range item.Instances {
-					instance = *i
-				}
-			}
-			return nil
-		},
-	); err != nil {
+	instance, err := getComputeResource(ctx, d, project, computeInstanceType, computeInstanceAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "zones":
+			return service.Instances.Get(project, location, name).Do()
+		}
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || instance == nil {
 		return nil, err
 	}
 
-	// If the specified resource is not present, API does not return any not found errors
-	if len(instance.Name) < 1 {
-		return nil, nil
-	}
+	return instance.(*compute.Instance), nil
+}
 
-	return &instance, nil
+// computeInstanceAggregatedList lists the instances of a project for getComputeResource
+func computeInstanceAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.Instances.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.InstanceAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, instance := range list.Instances {
+					fn(scope, instance.Name, instance)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 func getComputeInstanceIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -417,7 +434,7 @@ func getComputeInstanceIamPolicy(ctx context.Context, d *plugin.QueryData, h *pl
 	project := strings.Split(instance.SelfLink, "/")[6]
 	zone := getLastPathElement(types.SafeString(instance.Zone))
 
-	resp, err := service.Instances.GetIamPolicy(project, zone, instance.Name).Do()
+	resp, err := service.Instances.GetIamPolicy(project, zone, instance.Name).OptionsRequestedPolicyVersion(3).Do()
 	if err != nil {
 		return nil, err
 	}
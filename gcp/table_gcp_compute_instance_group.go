@@ -9,6 +9,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 func tableGcpComputeInstanceGroup(ctx context.Context) *plugin.Table {
@@ -16,9 +17,12 @@ func tableGcpComputeInstanceGroup(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_instance_group",
 		Description: "GCP Compute Instance Group",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeInstanceGroup,
-			Tags:       map[string]string{"service": "compute", "action": "instanceGroups.get"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeInstanceGroup,
+			Tags:    map[string]string{"service": "compute", "action": "instanceGroups.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeInstanceGroup,
@@ -217,7 +221,6 @@ func getComputeInstanceGroup(ctx context.Context, d *plugin.QueryData, h *plugin
 	}
 	project := projectId.(string)
 
-	var group compute.InstanceGroup
 	name := d.EqualsQuals["name"].GetStringValue()
 
 	// Create Service Connection
@@ -227,26 +230,36 @@ func getComputeInstanceGroup(ctx context.Context, d *plugin.QueryData, h *plugin
 		return nil, err
 	}
 
-	resp := service.InstanceGroups.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(ctx, func(page *compute.InstanceGroupAggregatedList) error {
-		for _, item := range page.Items {
-			for _, i := range item.InstanceGroups {
-				group = *i
-			}
+	group, err := getComputeResource(ctx, d, project, computeInstanceGroupType, computeInstanceGroupAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "zones":
+			return service.InstanceGroups.Get(project, location, name).Do()
+		case "regions":
+			return service.RegionInstanceGroups.Get(project, location, name).Do()
 		}
-		return nil
-	},
-	); err != nil {
-		plugin.Logger(ctx).Error("gcp_compute_instance_group.getComputeInstanceGroup", "api_err", err)
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || group == nil {
 		return nil, err
 	}
 
-	// If the specified resource is not present, API does not return any not found errors
-	if len(group.Name) < 1 {
-		return nil, nil
-	}
+	return group.(*compute.InstanceGroup), nil
+}
 
-	return &group, nil
+// computeInstanceGroupAggregatedList lists the instance groups of a project for getComputeResource
+func computeInstanceGroupAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.InstanceGroups.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.InstanceGroupAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, group := range list.InstanceGroups {
+					fn(scope, group.Name, group)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 func getComputeInstanceGroupInstances(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
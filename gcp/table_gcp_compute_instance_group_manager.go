@@ -9,6 +9,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 func tableGcpComputeInstanceGroupManager(ctx context.Context) *plugin.Table {
@@ -16,8 +17,11 @@ func tableGcpComputeInstanceGroupManager(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_instance_group_manager",
 		Description: "GCP Compute Instance Group Manager",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeInstanceGroupManager,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeInstanceGroupManager,
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeInstanceGroupManager,
@@ -263,7 +267,6 @@ func getComputeInstanceGroupManager(ctx context.Context, d *plugin.QueryData, h
 	}
 	project := projectId.(string)
 
-	var group compute.InstanceGroupManager
 	name := d.EqualsQuals["name"].GetStringValue()
 
 	// Create Service Connection
@@ -273,26 +276,36 @@ func getComputeInstanceGroupManager(ctx context.Context, d *plugin.QueryData, h
 		return nil, err
 	}
 
-	resp := service.InstanceGroupManagers.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(ctx, func(page *compute.InstanceGroupManagerAggregatedList) error {
-		for _, item := range page.Items {
-			for _, i := range item.InstanceGroupManagers {
-				group = *i
-			}
+	group, err := getComputeResource(ctx, d, project, computeInstanceGroupManagerType, computeInstanceGroupManagerAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "zones":
+			return service.InstanceGroupManagers.Get(project, location, name).Do()
+		case "regions":
+			return service.RegionInstanceGroupManagers.Get(project, location, name).Do()
 		}
-		return nil
-	},
-	); err != nil {
-		plugin.Logger(ctx).Error("gcp_compute_instance_group_manager.getComputeInstanceGroupManager", "api_err", err)
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || group == nil {
 		return nil, err
 	}
 
-	// If the specified resource is not present, API does not return any not found errors
-	if len(group.Name) < 1 {
-		return nil, nil
-	}
+	return group.(*compute.InstanceGroupManager), nil
+}
 
-	return &group, nil
+// computeInstanceGroupManagerAggregatedList lists the instance group managers of a project for getComputeResource
+func computeInstanceGroupManagerAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.InstanceGroupManagers.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.InstanceGroupManagerAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, manager := range list.InstanceGroupManagers {
+					fn(scope, manager.Name, manager)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 //// TRANSFORM FUNCTIONS
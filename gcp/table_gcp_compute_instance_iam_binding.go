@@ -0,0 +1,52 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeInstanceIamBinding(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_instance_iam_binding",
+		Description: "GCP Compute Instance IAM Binding",
+		List: &plugin.ListConfig{
+			ParentHydrate: listComputeInstances,
+			Hydrate:       listComputeInstanceIamBindings,
+			Tags:          map[string]string{"service": "compute", "action": "instances.getIamPolicy"},
+		},
+		Columns: iamBindingColumns([]*plugin.Column{
+			{
+				Name:        "instance_name",
+				Description: "The name of the instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeInstanceIamBindings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	instance := h.Item.(*compute.Instance)
+
+	// apply rate limiting
+	d.WaitForListRateLimit(ctx)
+
+	policy, err := getComputeInstanceIamPolicy(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_instance_iam_binding.listComputeInstanceIamBindings", "api_error", err)
+		return nil, err
+	}
+
+	_, err = streamIamPolicyBindings(ctx, d, policy, instance.Name, getLastPathElement(instance.Zone), strings.Split(instance.SelfLink, "/")[6])
+	return nil, err
+}
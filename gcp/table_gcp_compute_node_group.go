@@ -10,6 +10,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 //// TABLE DEFINITION
@@ -19,9 +20,12 @@ func tableGcpComputeNodeGroup(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_node_group",
 		Description: "GCP Compute Node Group",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeNodeGroup,
-			Tags:       map[string]string{"service": "compute", "action": "nodeGroups.get"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeNodeGroup,
+			Tags:    map[string]string{"service": "compute", "action": "nodeGroups.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeNodeGroups,
@@ -238,30 +242,36 @@ func getComputeNodeGroup(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 	}
 	project := projectId.(string)
 
-	var nodeGroup compute.NodeGroup
 	name := d.EqualsQuals["name"].GetStringValue()
 
-	resp := service.NodeGroups.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(
-		ctx,
-		func(page *compute.NodeGroupAggregatedList) error {
-			for _, item := range page.Items {
-				for _, i := range item.NodeGroups {
-					nodeGroup = *i
-				}
-			}
-			return nil
-		},
-	); err != nil {
+	nodeGroup, err := getComputeResource(ctx, d, project, computeNodeGroupType, computeNodeGroupAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "zones":
+			return service.NodeGroups.Get(project, location, name).Do()
+		}
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || nodeGroup == nil {
 		return nil, err
 	}
 
-	// If the specified resource is not present, API does not return any not found errors
-	if len(nodeGroup.Name) < 1 {
-		return nil, nil
-	}
+	return nodeGroup.(*compute.NodeGroup), nil
+}
 
-	return &nodeGroup, nil
+// computeNodeGroupAggregatedList lists the node groups of a project for getComputeResource
+func computeNodeGroupAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.NodeGroups.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.NodeGroupAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, nodeGroup := range list.NodeGroups {
+					fn(scope, nodeGroup.Name, nodeGroup)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 func getComputeNodeGroupIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -10,6 +10,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 //// TABLE DEFINITION
@@ -19,9 +20,12 @@ func tableGcpComputeNodeTemplate(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_node_template",
 		Description: "GCP Compute Node Template",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeNodeTemplate,
-			Tags:       map[string]string{"service": "compute", "action": "nodeTemplates.get"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeNodeTemplate,
+			Tags:    map[string]string{"service": "compute", "action": "nodeTemplates.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeNodeTemplates,
@@ -246,30 +250,36 @@ func getComputeNodeTemplate(ctx context.Context, d *plugin.QueryData, h *plugin.
 	}
 	project := projectId.(string)
 
-	var nodeTemplate compute.NodeTemplate
 	name := d.EqualsQuals["name"].GetStringValue()
 
-	resp := service.NodeTemplates.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(
-		ctx,
-		func(page *compute.NodeTemplateAggregatedList) error {
-			for _, item := range page.Items {
-				for _, i := range item.NodeTemplates {
-					nodeTemplate = *i
-				}
-			}
-			return nil
-		},
-	); err != nil {
+	nodeTemplate, err := getComputeResource(ctx, d, project, computeNodeTemplateType, computeNodeTemplateAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "regions":
+			return service.NodeTemplates.Get(project, location, name).Do()
+		}
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || nodeTemplate == nil {
 		return nil, err
 	}
 
-	// If the specified resource is not present, API does not return any not found errors
-	if len(nodeTemplate.Name) < 1 {
-		return nil, nil
-	}
+	return nodeTemplate.(*compute.NodeTemplate), nil
+}
 
-	return &nodeTemplate, nil
+// computeNodeTemplateAggregatedList lists the node templates of a project for getComputeResource
+func computeNodeTemplateAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.NodeTemplates.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.NodeTemplateAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, nodeTemplate := range list.NodeTemplates {
+					fn(scope, nodeTemplate.Name, nodeTemplate)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 func getComputeNodeTemplateIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
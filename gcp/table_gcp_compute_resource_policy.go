@@ -10,6 +10,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 //// TABLE DEFINITION
@@ -19,9 +20,12 @@ func tableGcpComputeResourcePolicy(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_resource_policy",
 		Description: "GCP Compute Resource Policy",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeResourcePolicy,
-			Tags:       map[string]string{"service": "compute", "action": "resourcePolicies.get"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeResourcePolicy,
+			Tags:    map[string]string{"service": "compute", "action": "resourcePolicies.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeResourcePolicies,
@@ -218,7 +222,6 @@ func getComputeResourcePolicy(ctx context.Context, d *plugin.QueryData, h *plugi
 	}
 	project := projectId.(string)
 
-	var resourcePolicy compute.ResourcePolicy
 	name := d.EqualsQuals["name"].GetStringValue()
 
 	// Return nil, if no input provided
@@ -226,27 +229,34 @@ func getComputeResourcePolicy(ctx context.Context, d *plugin.QueryData, h *plugi
 		return nil, nil
 	}
 
-	resp := service.ResourcePolicies.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(
-		ctx,
-		func(page *compute.ResourcePolicyAggregatedList) error {
-			for _, item := range page.Items {
-				for _, i := range item.ResourcePolicies {
-					resourcePolicy = *i
-				}
-			}
-			return nil
-		},
-	); err != nil {
+	resourcePolicy, err := getComputeResource(ctx, d, project, computeResourcePolicyType, computeResourcePolicyAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "regions":
+			return service.ResourcePolicies.Get(project, location, name).Do()
+		}
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || resourcePolicy == nil {
 		return nil, err
 	}
 
-	// If the specified resource is not present, API does not return any not found errors
-	if len(resourcePolicy.Name) < 1 {
-		return nil, nil
-	}
+	return resourcePolicy.(*compute.ResourcePolicy), nil
+}
 
-	return &resourcePolicy, nil
+// computeResourcePolicyAggregatedList lists the resource policies of a project for getComputeResource
+func computeResourcePolicyAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.ResourcePolicies.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.ResourcePolicyAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, resourcePolicy := range list.ResourcePolicies {
+					fn(scope, resourcePolicy.Name, resourcePolicy)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 func getComputeResourcePolicyIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
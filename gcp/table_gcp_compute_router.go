@@ -10,6 +10,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 //// TABLE DEFINITION
@@ -19,9 +20,12 @@ func tableGcpComputeRouter(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_router",
 		Description: "GCP Compute Router",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeRouter,
-			Tags:       map[string]string{"service": "compute", "action": "routers.get"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeRouter,
+			Tags:    map[string]string{"service": "compute", "action": "routers.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeRouters,
@@ -205,31 +209,36 @@ func getComputeRouter(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	}
 	project := projectId.(string)
 
-	var router compute.Router
 	name := d.EqualsQuals["name"].GetStringValue()
 
-	resp := service.Routers.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(
-		ctx,
-		func(page *compute.RouterAggregatedList) error {
-			for _, item := range page.Items {
-				for _, i := range item.Routers {
-					router = *i
-				}
-			}
-			return nil
-		},
-	); err != nil {
+	router, err := getComputeResource(ctx, d, project, computeRouterType, computeRouterAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "regions":
+			return service.Routers.Get(project, location, name).Do()
+		}
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || router == nil {
 		return nil, err
 	}
 
-	// If the specified resource is not present, API does not return any not found errors
-	// it returns the data as {<nil> []   0 []   []    {0 map[]} [] []}
-	if len(router.Name) < 1 {
-		return nil, nil
-	}
+	return router.(*compute.Router), nil
+}
 
-	return &router, nil
+// computeRouterAggregatedList lists the routers of a project for getComputeResource
+func computeRouterAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.Routers.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.RouterAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, router := range list.Routers {
+					fn(scope, router.Name, router)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 //// TRANSFORM FUNCTIONS
@@ -10,6 +10,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 func tableGcpComputeSubnetwork(ctx context.Context) *plugin.Table {
@@ -17,9 +18,12 @@ func tableGcpComputeSubnetwork(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_subnetwork",
 		Description: "GCP Compute Subnetwork",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeSubnetwork,
-			Tags:       map[string]string{"service": "compute", "action": "subnetworks.get"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeSubnetwork,
+			Tags:    map[string]string{"service": "compute", "action": "subnetworks.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeSubnetworks,
@@ -308,28 +312,36 @@ func getComputeSubnetwork(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 	}
 	project := projectId.(string)
 
-	var subnetwork compute.Subnetwork
 	name := d.EqualsQuals["name"].GetStringValue()
 
-	resp := service.Subnetworks.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(ctx, func(page *compute.SubnetworkAggregatedList) error {
-		for _, item := range page.Items {
-			for _, i := range item.Subnetworks {
-				subnetwork = *i
-			}
+	subnetwork, err := getComputeResource(ctx, d, project, computeSubnetworkType, computeSubnetworkAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "regions":
+			return service.Subnetworks.Get(project, location, name).Do()
 		}
-		return nil
-	},
-	); err != nil {
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || subnetwork == nil {
 		return nil, err
 	}
 
-	// If the specified resource is not present, API does not return any not found errors
-	if len(subnetwork.Name) < 1 {
-		return nil, nil
-	}
+	return subnetwork.(*compute.Subnetwork), nil
+}
 
-	return &subnetwork, nil
+// computeSubnetworkAggregatedList lists the subnetworks of a project for getComputeResource
+func computeSubnetworkAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.Subnetworks.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.SubnetworkAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, subnetwork := range list.Subnetworks {
+					fn(scope, subnetwork.Name, subnetwork)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 func getComputeSubnetworkIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -345,7 +357,7 @@ func getComputeSubnetworkIamPolicy(ctx context.Context, d *plugin.QueryData, h *
 	project := strings.Split(subnetwork.SelfLink, "/")[6]
 	regionName := getLastPathElement(types.SafeString(subnetwork.Region))
 
-	resp, err = service.Subnetworks.GetIamPolicy(project, regionName, subnetwork.Name).Do()
+	resp, err = service.Subnetworks.GetIamPolicy(project, regionName, subnetwork.Name).OptionsRequestedPolicyVersion(3).Do()
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,52 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeSubnetworkIamBinding(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_subnetwork_iam_binding",
+		Description: "GCP Compute Subnetwork IAM Binding",
+		List: &plugin.ListConfig{
+			ParentHydrate: listComputeSubnetworks,
+			Hydrate:       listComputeSubnetworkIamBindings,
+			Tags:          map[string]string{"service": "compute", "action": "subnetworks.getIamPolicy"},
+		},
+		Columns: iamBindingColumns([]*plugin.Column{
+			{
+				Name:        "subnetwork_name",
+				Description: "The name of the subnetwork.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeSubnetworkIamBindings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	subnetwork := h.Item.(*compute.Subnetwork)
+
+	// apply rate limiting
+	d.WaitForListRateLimit(ctx)
+
+	policy, err := getComputeSubnetworkIamPolicy(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_compute_subnetwork_iam_binding.listComputeSubnetworkIamBindings", "api_error", err)
+		return nil, err
+	}
+
+	_, err = streamIamPolicyBindings(ctx, d, policy, subnetwork.Name, getLastPathElement(subnetwork.Region), strings.Split(subnetwork.SelfLink, "/")[6])
+	return nil, err
+}
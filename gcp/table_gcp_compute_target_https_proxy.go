@@ -9,6 +9,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 //// TABLE DEFINITION
@@ -18,9 +19,12 @@ func tableGcpComputeTargetHttpsProxy(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_target_https_proxy",
 		Description: "GCP Compute Target Https Proxy",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeTargetHttpsProxy,
-			Tags:       map[string]string{"service": "compute", "action": "targetHttpProxies.get"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeTargetHttpsProxy,
+			Tags:    map[string]string{"service": "compute", "action": "targetHttpProxies.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeTargetHttpsProxies,
@@ -224,25 +228,36 @@ func getComputeTargetHttpsProxy(ctx context.Context, d *plugin.QueryData, h *plu
 	project := projectId.(string)
 	name := d.EqualsQuals["name"].GetStringValue()
 
-	var targetHttpsProxy compute.TargetHttpsProxy
-	resp := service.TargetHttpsProxies.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(ctx, func(page *compute.TargetHttpsProxyAggregatedList) error {
-		for _, item := range page.Items {
-			for _, i := range item.TargetHttpsProxies {
-				targetHttpsProxy = *i
-			}
+	targetHttpsProxy, err := getComputeResource(ctx, d, project, computeTargetHttpsProxyType, computeTargetHttpsProxyAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "regions":
+			return service.RegionTargetHttpsProxies.Get(project, location, name).Do()
+		case "global":
+			return service.TargetHttpsProxies.Get(project, name).Do()
 		}
-		return nil
-	},
-	); err != nil {
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || targetHttpsProxy == nil {
 		return nil, err
 	}
 
-	if len(targetHttpsProxy.Name) < 1 {
-		return nil, nil
-	}
+	return targetHttpsProxy.(*compute.TargetHttpsProxy), nil
+}
 
-	return &targetHttpsProxy, nil
+// computeTargetHttpsProxyAggregatedList lists the target HTTPS proxies of a project for getComputeResource
+func computeTargetHttpsProxyAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.TargetHttpsProxies.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.TargetHttpsProxyAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, proxy := range list.TargetHttpsProxies {
+					fn(scope, proxy.Name, proxy)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 //// TRANSFORM FUNCTIONS
@@ -10,6 +10,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 func tableGcpComputeTargetPool(ctx context.Context) *plugin.Table {
@@ -17,9 +18,12 @@ func tableGcpComputeTargetPool(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_target_pool",
 		Description: "GCP Compute Target Pool",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeTargetPool,
-			Tags:       map[string]string{"service": "compute", "action": "targetPools.get"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeTargetPool,
+			Tags:    map[string]string{"service": "compute", "action": "targetPools.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeTargetPools,
@@ -194,30 +198,36 @@ func getComputeTargetPool(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 	}
 	project := projectId.(string)
 
-	var targetPool compute.TargetPool
 	name := d.EqualsQuals["name"].GetStringValue()
 
-	resp := service.TargetPools.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(
-		ctx,
-		func(page *compute.TargetPoolAggregatedList) error {
-			for _, item := range page.Items {
-				for _, i := range item.TargetPools {
-					targetPool = *i
-				}
-			}
-			return nil
-		},
-	); err != nil {
+	targetPool, err := getComputeResource(ctx, d, project, computeTargetPoolType, computeTargetPoolAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "regions":
+			return service.TargetPools.Get(project, location, name).Do()
+		}
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || targetPool == nil {
 		return nil, err
 	}
 
-	// If the specified resource is not present, API does not return any not found errors
-	if len(targetPool.Name) < 1 {
-		return nil, nil
-	}
+	return targetPool.(*compute.TargetPool), nil
+}
 
-	return &targetPool, nil
+// computeTargetPoolAggregatedList lists the target pools of a project for getComputeResource
+func computeTargetPoolAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.TargetPools.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.TargetPoolAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, targetPool := range list.TargetPools {
+					fn(scope, targetPool.Name, targetPool)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 //// TRANSFORM FUNCTION
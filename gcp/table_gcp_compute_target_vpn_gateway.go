@@ -10,6 +10,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 //// TABLE DEFINITION
@@ -19,9 +20,12 @@ func tableGcpComputeTargetVpnGateway(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_target_vpn_gateway",
 		Description: "GCP Compute Target VPN Gateway",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeTargetVpnGateway,
-			Tags:       map[string]string{"service": "compute", "action": "targetVpnGateways.get"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeTargetVpnGateway,
+			Tags:    map[string]string{"service": "compute", "action": "targetVpnGateways.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeTargetVpnGateways,
@@ -194,33 +198,39 @@ func getComputeTargetVpnGateway(ctx context.Context, d *plugin.QueryData, h *plu
 	}
 	project := projectId.(string)
 
-	var targetVpnGateway compute.TargetVpnGateway
 	name := d.EqualsQuals["name"].GetStringValue()
-	if name != "" {
+	if name == "" {
 		return nil, nil
 	}
 
-	resp := service.TargetVpnGateways.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(
-		ctx,
-		func(page *compute.TargetVpnGatewayAggregatedList) error {
-			for _, item := range page.Items {
-				for _, i := range item.TargetVpnGateways {
-					targetVpnGateway = *i
-				}
-			}
-			return nil
-		},
-	); err != nil {
+	targetVpnGateway, err := getComputeResource(ctx, d, project, computeTargetVpnGatewayType, computeTargetVpnGatewayAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "regions":
+			return service.TargetVpnGateways.Get(project, location, name).Do()
+		}
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || targetVpnGateway == nil {
 		return nil, err
 	}
 
-	// If the specified resource is not present, API does not return any not found errors
-	if len(targetVpnGateway.Name) < 1 {
-		return nil, nil
-	}
+	return targetVpnGateway.(*compute.TargetVpnGateway), nil
+}
 
-	return &targetVpnGateway, nil
+// computeTargetVpnGatewayAggregatedList lists the target VPN gateways of a project for getComputeResource
+func computeTargetVpnGatewayAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.TargetVpnGateways.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.TargetVpnGatewayAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, gateway := range list.TargetVpnGateways {
+					fn(scope, gateway.Name, gateway)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 //// TRANSFORM FUNCTIONS
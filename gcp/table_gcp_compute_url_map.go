@@ -10,6 +10,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 //// TABLE DEFINITION
@@ -19,9 +20,12 @@ func tableGcpComputeURLMap(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_url_map",
 		Description: "GCP Compute URL Map",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeURLMap,
-			Tags:       map[string]string{"service": "compute", "action": "urlMaps.get"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeURLMap,
+			Tags:    map[string]string{"service": "compute", "action": "urlMaps.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeURLMaps,
@@ -267,30 +271,38 @@ func getComputeURLMap(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	}
 	project := projectId.(string)
 
-	var urlMap compute.UrlMap
 	name := d.EqualsQuals["name"].GetStringValue()
 
-	resp := service.UrlMaps.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(
-		ctx,
-		func(page *compute.UrlMapsAggregatedList) error {
-			for _, item := range page.Items {
-				for _, i := range item.UrlMaps {
-					urlMap = *i
-				}
-			}
-			return nil
-		},
-	); err != nil {
+	urlMap, err := getComputeResource(ctx, d, project, computeUrlMapType, computeUrlMapAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "regions":
+			return service.RegionUrlMaps.Get(project, location, name).Do()
+		case "global":
+			return service.UrlMaps.Get(project, name).Do()
+		}
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || urlMap == nil {
 		return nil, err
 	}
 
-	// If the specified resource is not present, API does not return any not found errors
-	if len(urlMap.Name) < 1 {
-		return nil, nil
-	}
+	return urlMap.(*compute.UrlMap), nil
+}
 
-	return &urlMap, nil
+// computeUrlMapAggregatedList lists the URL maps of a project for getComputeResource
+func computeUrlMapAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.UrlMaps.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.UrlMapsAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, urlMap := range list.UrlMaps {
+					fn(scope, urlMap.Name, urlMap)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 //// TRANSFORM FUNCTIONS
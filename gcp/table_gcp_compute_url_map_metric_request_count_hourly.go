@@ -0,0 +1,47 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/compute/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpComputeURLMapMetricRequestCountHourly(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_compute_url_map_metric_request_count_hourly",
+		Description: "GCP Compute URL Map Metrics - Request Count (Hourly)",
+		List: &plugin.ListConfig{
+			ParentHydrate: listComputeURLMaps,
+			Hydrate:       listComputeURLMapMetricRequestCountHourly,
+			KeyColumns:    monitoringMetricKeyColumns(nil),
+			Tags:          map[string]string{"service": "monitoring", "action": "timeSeries.list"},
+		},
+		Columns: monitoringMetricColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the URL map.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DimensionValue"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listComputeURLMapMetricRequestCountHourly(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	urlMapInfo := h.Item.(*compute.UrlMap)
+
+	// Regional load balancers report to other metrics, depending on their load balancing scheme
+	if urlMapInfo.Region != "" {
+		return nil, nil
+	}
+	dimensionValue := "\"" + urlMapInfo.Name + "\""
+
+	return listMonitorMetricStatistics(ctx, d, h, "HOURLY", "\"loadbalancing.googleapis.com/https/request_count\"", "resource.label.url_map_name = ", dimensionValue, urlMapInfo.Name, "global")
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 
 	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
 )
 
 //// TABLE DEFINITION
@@ -19,9 +20,12 @@ func tableGcpComputeVpnTunnel(ctx context.Context) *plugin.Table {
 		Name:        "gcp_compute_vpn_tunnel",
 		Description: "GCP Compute VPN Tunnel",
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getComputeVpnTunnel,
-			Tags:       map[string]string{"service": "compute", "action": "vpnTunnels.get"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "name", Require: plugin.Required},
+				{Name: "location", Require: plugin.Optional},
+			},
+			Hydrate: getComputeVpnTunnel,
+			Tags:    map[string]string{"service": "compute", "action": "vpnTunnels.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listComputeVpnTunnels,
@@ -265,30 +269,36 @@ func getComputeVpnTunnel(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 	}
 	project := projectId.(string)
 
-	var vpnTunnel compute.VpnTunnel
 	name := d.EqualsQuals["name"].GetStringValue()
 
-	resp := service.VpnTunnels.AggregatedList(project).Filter("name=" + name)
-	if err := resp.Pages(
-		ctx,
-		func(page *compute.VpnTunnelAggregatedList) error {
-			for _, item := range page.Items {
-				for _, i := range item.VpnTunnels {
-					vpnTunnel = *i
-				}
-			}
-			return nil
-		},
-	); err != nil {
+	vpnTunnel, err := getComputeResource(ctx, d, project, computeVpnTunnelType, computeVpnTunnelAggregatedList(service, project), name, func(scopeType string, location string) (interface{}, error) {
+		switch scopeType {
+		case "regions":
+			return service.VpnTunnels.Get(project, location, name).Do()
+		}
+		// The resource is not present in the project, or not in a location it can be in
+		return nil, nil
+	})
+	if err != nil || vpnTunnel == nil {
 		return nil, err
 	}
 
-	// If the specified resource is not present, API does not return any not found errors
-	if len(vpnTunnel.Name) < 1 {
-		return nil, nil
-	}
+	return vpnTunnel.(*compute.VpnTunnel), nil
+}
 
-	return &vpnTunnel, nil
+// computeVpnTunnelAggregatedList lists the VPN tunnels of a project for getComputeResource
+func computeVpnTunnelAggregatedList(service *compute.Service, project string) computeAggregatedList {
+	return func(ctx context.Context, filter string, fields googleapi.Field, fn func(scope string, name string, resource interface{})) error {
+		call := filterComputeAggregatedList(service.VpnTunnels.AggregatedList(project), filter, fields)
+		return call.Pages(ctx, func(page *compute.VpnTunnelAggregatedList) error {
+			for scope, list := range page.Items {
+				for _, vpnTunnel := range list.VpnTunnels {
+					fn(scope, vpnTunnel.Name, vpnTunnel)
+				}
+			}
+			return nil
+		})
+	}
 }
 
 func getVpnTunnelAka(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -2,7 +2,6 @@ package gcp
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"github.com/turbot/go-kit/types"
@@ -28,8 +27,8 @@ func tableGcpDataplexAsset(ctx context.Context) *plugin.Table {
 			KeyColumns: plugin.KeyColumnSlice{
 				{Name: "lake_name", Require: plugin.Optional, Operators: []string{"="}},
 				{Name: "zone_name", Require: plugin.Optional, Operators: []string{"="}},
-				{Name: "display_name", Require: plugin.Optional, Operators: []string{"="}},
-				{Name: "state", Require: plugin.Optional, Operators: []string{"="}},
+				{Name: "display_name", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "state", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 			},
 			Tags: map[string]string{"service": "dataplex", "action": "assets.list"},
 		},
@@ -172,19 +171,11 @@ func listDataplexAssets(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 		return nil, err
 	}
 
-	var filters []string
-	if d.EqualsQualString("display_name") != "" {
-		filters = append(filters, fmt.Sprintf("displayName = \"%s\"", d.EqualsQualString("display_name")))
-	}
-
-	if d.EqualsQualString("state") != "" {
-		filters = append(filters, fmt.Sprintf("state = \"%s\"", d.EqualsQualString("state")))
-	}
-
-	filterString := ""
-	if len(filters) > 0 {
-		filterString = strings.Join(filters, "AND")
+	filterQuals := []filterQualMap{
+		{"display_name", "displayName", "string"},
+		{"state", "state", "string"},
 	}
+	filterString := buildAIP160Filter(filterQuals, d.Quals)
 
 	// Max limit is set as per documentation
 	pageSize := types.Int64(1000)
@@ -2,7 +2,6 @@ package gcp
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"github.com/turbot/go-kit/types"
@@ -25,8 +24,8 @@ func tableGcpDataplexLake(ctx context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			Hydrate: listDataplexLakes,
 			KeyColumns: plugin.KeyColumnSlice{
-				{Name: "display_name", Require: plugin.Optional, Operators: []string{"="}},
-				{Name: "state", Require: plugin.Optional, Operators: []string{"="}},
+				{Name: "display_name", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "state", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 			},
 			Tags: map[string]string{"service": "dataplex", "action": "lakes.list"},
 		},
@@ -159,18 +158,15 @@ func listDataplexLakes(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 		return nil, err
 	}
 
-	var filters []string
-	if d.EqualsQualString("display_name") != "" {
-		filters = append(filters, fmt.Sprintf("displayName = \"%s\"", d.EqualsQualString("display_name")))
-	}
-
-	if d.EqualsQualString("state") != "" {
-		filters = append(filters, fmt.Sprintf("state = \"%s\"", d.EqualsQualString("state")))
+	filterQuals := []filterQualMap{
+		{"display_name", "displayName", "string"},
+		{"state", "state", "string"},
 	}
 
+	// The lakes are also listed as the parent of zones and tasks, whose quals don't apply to them
 	filterString := ""
-	if len(filters) > 0 {
-		filterString = strings.Join(filters, "AND")
+	if d.Table.Name == "gcp_dataplex_lake" {
+		filterString = buildAIP160Filter(filterQuals, d.Quals)
 	}
 
 	// Max limit is set as per documentation
@@ -228,6 +224,10 @@ func getDataplexLake(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 		return nil, nil
 	}
 
+	if err := getMatrixLocationError(ctx, d); err != nil {
+		return nil, err
+	}
+
 	// Check for handle Array index out of range error for any wrong input in query parameter.
 	// We should not make the API call for other regions.
 	splitName := strings.Split(name, "/")
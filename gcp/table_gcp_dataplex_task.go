@@ -2,7 +2,6 @@ package gcp
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"github.com/turbot/go-kit/types"
@@ -27,8 +26,8 @@ func tableGcpDataplexTask(ctx context.Context) *plugin.Table {
 			Hydrate:       listDataplexTasks,
 			KeyColumns: plugin.KeyColumnSlice{
 				{Name: "lake_name", Require: plugin.Optional, Operators: []string{"="}},
-				{Name: "display_name", Require: plugin.Optional, Operators: []string{"="}},
-				{Name: "state", Require: plugin.Optional, Operators: []string{"="}},
+				{Name: "display_name", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+				{Name: "state", Require: plugin.Optional, Operators: []string{"=", "<>"}},
 			},
 			Tags: map[string]string{"service": "dataplex", "action": "tasks.list"},
 		},
@@ -166,19 +165,11 @@ func listDataplexTasks(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 		return nil, err
 	}
 
-	var filters []string
-	if d.EqualsQualString("display_name") != "" {
-		filters = append(filters, fmt.Sprintf("displayName = \"%s\"", d.EqualsQualString("display_name")))
-	}
-
-	if d.EqualsQualString("state") != "" {
-		filters = append(filters, fmt.Sprintf("state = \"%s\"", d.EqualsQualString("state")))
-	}
-
-	filterString := ""
-	if len(filters) > 0 {
-		filterString = strings.Join(filters, "AND")
+	filterQuals := []filterQualMap{
+		{"display_name", "displayName", "string"},
+		{"state", "state", "string"},
 	}
+	filterString := buildAIP160Filter(filterQuals, d.Quals)
 
 	// Max limit is set as per documentation
 	pageSize := types.Int64(1000)
@@ -226,6 +217,10 @@ func getDataplexTask(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 		return nil, nil
 	}
 
+	if err := getMatrixLocationError(ctx, d); err != nil {
+		return nil, err
+	}
+
 	// Check for handle Array index out of range error for any wrong input in query parameter.
 	// We should not make the API call for other regions.
 	splitName := strings.Split(name, "/")
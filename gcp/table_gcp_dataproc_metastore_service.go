@@ -277,6 +277,10 @@ func getDataprocMetastoreService(ctx context.Context, d *plugin.QueryData, h *pl
 		return nil, nil
 	}
 
+	if err := getMatrixLocationError(ctx, d); err != nil {
+		return nil, err
+	}
+
 	// Restrict the APi call for other locations
 	if len(strings.Split(name, "/")) > 3 && strings.Split(name, "/")[3] != matrixLocation {
 		return nil, nil
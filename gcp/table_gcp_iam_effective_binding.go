@@ -0,0 +1,210 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+	cloudresourcemanager3 "google.golang.org/api/cloudresourcemanager/v3"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpIAMEffectiveBinding(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_iam_effective_binding",
+		Description: "GCP IAM Effective Binding",
+		List: &plugin.ListConfig{
+			Hydrate: listGcpIamEffectiveBindings,
+			Tags:    map[string]string{"service": "resourcemanager", "action": "projects.getIamPolicy"},
+		},
+		Columns: append([]*plugin.Column{
+			{
+				Name:        "member",
+				Description: "The principal granted the role, e.g. user:alice@example.com.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "member_type",
+				Description: "The type of the principal, e.g. user, serviceAccount, group, domain or allUsers.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "role",
+				Description: "The role granted to the member, e.g. roles/owner.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_type",
+				Description: "The type of the resource whose IAM policy grants the role, i.e. organization, folder or project.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "source_resource",
+				Description: "The resource whose IAM policy grants the role, e.g. organizations/123456789.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "inherited",
+				Description: "True if the role is granted on an ancestor of the project, rather than the project itself.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(iamEffectiveBindingInherited),
+			},
+			{
+				Name:        "condition_title",
+				Description: "The title of the condition of the binding, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConditionTitle").NullIfZero(),
+			},
+			{
+				Name:        "condition_description",
+				Description: "The description of the condition of the binding, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConditionDescription").NullIfZero(),
+			},
+			{
+				Name:        "condition_expression",
+				Description: "The CEL expression of the condition of the binding, if any.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ConditionExpression").NullIfZero(),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant("global"),
+			},
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+			},
+		}, iamConditionColumns()...),
+	}
+}
+
+type iamEffectiveBinding struct {
+	iamBindingRow
+	SourceType     string
+	SourceResource string
+	Project        string
+}
+
+//// FETCH FUNCTIONS
+
+func listGcpIamEffectiveBindings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	// Create Service Connections
+	service, err := CloudResourceManagerService(ctx, d)
+	if err != nil {
+		logger.Error("gcp_iam_effective_binding.listGcpIamEffectiveBindings", "service_error", err)
+		return nil, err
+	}
+	serviceV3, err := CloudResourceManagerServiceV3(ctx, d)
+	if err != nil {
+		logger.Error("gcp_iam_effective_binding.listGcpIamEffectiveBindings", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// The ancestry lists the project first, then its folders, then the organization
+	ancestry, err := service.Projects.GetAncestry(project, &cloudresourcemanager.GetAncestryRequest{}).Context(ctx).Do()
+	if err != nil {
+		logger.Error("gcp_iam_effective_binding.listGcpIamEffectiveBindings", "ancestry_error", err)
+		return nil, err
+	}
+
+	// List the bindings from the top of the hierarchy down
+	for i := len(ancestry.Ancestor) - 1; i >= 0; i-- {
+		resourceId := ancestry.Ancestor[i].ResourceId
+		if resourceId == nil {
+			continue
+		}
+		resource := resourceId.Type + "s/" + resourceId.Id
+
+		policy, err := getResourceIamPolicy(ctx, d, serviceV3, resourceId.Type, resource)
+		if err != nil {
+			logger.Error("gcp_iam_effective_binding.listGcpIamEffectiveBindings", "api_error", err, "resource", resource)
+			return nil, err
+		}
+
+		for _, binding := range policy.Bindings {
+			var conditionTitle, conditionDescription, conditionExpression string
+			if binding.Condition != nil {
+				conditionTitle, conditionDescription, conditionExpression = binding.Condition.Title, binding.Condition.Description, binding.Condition.Expression
+			}
+			for _, row := range newIAMBindingRows(binding.Members, binding.Role, conditionTitle, conditionDescription, conditionExpression) {
+				d.StreamListItem(ctx, &iamEffectiveBinding{
+					iamBindingRow:  row,
+					SourceType:     resourceId.Type,
+					SourceResource: resource,
+					Project:        project,
+				})
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// getResourceIamPolicy returns the IAM policy of an organization, folder or project, with
+// conditional bindings. Organization and folder policies are shared by many projects, so
+// they are cached per connection.
+func getResourceIamPolicy(ctx context.Context, d *plugin.QueryData, service *cloudresourcemanager3.Service, resourceType string, resource string) (*cloudresourcemanager3.Policy, error) {
+	cacheKey := "IamPolicy/" + resource
+	if resourceType != "project" {
+		if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+			return cachedData.(*cloudresourcemanager3.Policy), nil
+		}
+	}
+
+	// apply rate limiting
+	d.WaitForListRateLimit(ctx)
+
+	req := &cloudresourcemanager3.GetIamPolicyRequest{
+		Options: &cloudresourcemanager3.GetPolicyOptions{RequestedPolicyVersion: 3},
+	}
+
+	var policy *cloudresourcemanager3.Policy
+	var err error
+	switch resourceType {
+	case "organization":
+		policy, err = service.Organizations.GetIamPolicy(resource, req).Context(ctx).Do()
+	case "folder":
+		policy, err = service.Folders.GetIamPolicy(resource, req).Context(ctx).Do()
+	default:
+		policy, err = service.Projects.GetIamPolicy(resource, req).Context(ctx).Do()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if resourceType != "project" {
+		d.ConnectionManager.Cache.Set(cacheKey, policy)
+	}
+	return policy, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func iamEffectiveBindingInherited(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	return d.HydrateItem.(*iamEffectiveBinding).SourceType != "project", nil
+}
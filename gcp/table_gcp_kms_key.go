@@ -87,6 +87,20 @@ func tableGcpKmsKey(ctx context.Context) *plugin.Table {
 				Hydrate:     getKeyIamPolicy,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "is_public",
+				Description: "True if the IAM policy grants any role to allUsers or allAuthenticatedUsers.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getKeyIamPolicy,
+				Transform:   transform.FromValue().Transform(iamPolicyIsPublic),
+			},
+			{
+				Name:        "public_roles",
+				Description: "The roles the IAM policy grants to allUsers, allAuthenticatedUsers or a whole domain, with the member each is granted to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getKeyIamPolicy,
+				Transform:   transform.FromValue().Transform(iamPolicyPublicRoles),
+			},
 			{
 				Name:        "labels",
 				Description: "Labels with user-defined metadata.",
@@ -238,7 +252,7 @@ func getKeyIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 	}
 	param := h.Item.(*cloudkms.CryptoKey).Name
 
-	resp, err := service.Projects.Locations.KeyRings.CryptoKeys.GetIamPolicy(param).Do()
+	resp, err := service.Projects.Locations.KeyRings.CryptoKeys.GetIamPolicy(param).OptionsRequestedPolicyVersion(3).Do()
 	if err != nil {
 		return nil, err
 	}
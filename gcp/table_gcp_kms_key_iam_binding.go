@@ -0,0 +1,93 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/cloudkms/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpKmsKeyIamBinding(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_kms_key_iam_binding",
+		Description: "GCP KMS Key IAM Binding",
+		List: &plugin.ListConfig{
+			ParentHydrate: listKeyRingDetails,
+			Hydrate:       listKmsKeyIamBindings,
+			Tags:          map[string]string{"service": "cloudkms", "action": "cryptoKeys.getIamPolicy"},
+		},
+		GetMatrixItemFunc: BuildLocationList,
+		Columns: iamBindingColumns([]*plugin.Column{
+			{
+				Name:        "key_name",
+				Description: "The name of the key.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceName").Transform(lastPathElement),
+			},
+			{
+				Name:        "key_ring_name",
+				Description: "The name of the key ring the key belongs to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceName").Transform(kmsKeyIamBindingKeyRingName),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listKmsKeyIamBindings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	keyRing := h.Item.(*cloudkms.KeyRing)
+
+	// Create Service Connection
+	service, err := KMSService(ctx, d)
+	if err != nil {
+		logger.Error("gcp_kms_key_iam_binding.listKmsKeyIamBindings", "service_error", err)
+		return nil, err
+	}
+
+	// Key ring names are of the form projects/<project>/locations/<location>/keyRings/<key ring>
+	splitName := strings.Split(keyRing.Name, "/")
+	project, location := splitName[1], splitName[3]
+
+	resp := service.Projects.Locations.KeyRings.CryptoKeys.List(keyRing.Name)
+	if err := resp.Pages(ctx, func(page *cloudkms.ListCryptoKeysResponse) error {
+		for _, key := range page.CryptoKeys {
+			// apply rate limiting
+			d.WaitForListRateLimit(ctx)
+
+			policy, err := service.Projects.Locations.KeyRings.CryptoKeys.GetIamPolicy(key.Name).OptionsRequestedPolicyVersion(3).Context(ctx).Do()
+			if err != nil {
+				return err
+			}
+
+			more, err := streamIamPolicyBindings(ctx, d, policy, key.Name, location, project)
+			if err != nil {
+				return err
+			}
+			if !more {
+				page.NextPageToken = ""
+				return nil
+			}
+		}
+		return nil
+	}); err != nil {
+		logger.Error("gcp_kms_key_iam_binding.listKmsKeyIamBindings", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func kmsKeyIamBindingKeyRingName(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	return strings.Split(d.Value.(string), "/")[5], nil
+}
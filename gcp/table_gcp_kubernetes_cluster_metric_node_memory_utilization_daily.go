@@ -0,0 +1,46 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/container/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpKubernetesClusterMetricNodeMemoryUtilizationDaily(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_kubernetes_cluster_metric_node_memory_utilization_daily",
+		Description: "GCP Kubernetes Cluster Metrics - Node Memory Utilization (Daily)",
+		List: &plugin.ListConfig{
+			ParentHydrate: listKubernetesClusters,
+			Hydrate:       listKubernetesClusterMetricNodeMemoryUtilizationDaily,
+			KeyColumns:    monitoringMetricKeyColumns(nil),
+			Tags:          map[string]string{"service": "monitoring", "action": "timeSeries.list"},
+		},
+		Columns: monitoringMetricColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the cluster. The node of each data point is in resource ->> 'labels' ->> 'node_name'.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DimensionValue"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listKubernetesClusterMetricNodeMemoryUtilizationDaily(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	clusterInfo := h.Item.(*container.Cluster)
+
+	dimensionValue := "\"" + clusterInfo.Name + "\""
+
+	// Cluster names are only unique within a location
+	dimensionKey := "resource.label.location = \"" + clusterInfo.Location + "\" AND resource.label.cluster_name = "
+
+	return listMonitorMetricStatistics(ctx, d, h, "DAILY", "\"kubernetes.io/node/memory/allocatable_utilization\"", dimensionKey, dimensionValue, clusterInfo.Name, clusterInfo.Location)
+}
@@ -0,0 +1,220 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/monitoring/v3"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpMonitoringMQLQuery(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_monitoring_mql_query",
+		Description: "GCP Monitoring MQL Query",
+		List: &plugin.ListConfig{
+			Hydrate: listMonitoringMQLQuery,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "query", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "start_time", Require: plugin.Optional, Operators: []string{"="}},
+				{Name: "end_time", Require: plugin.Optional, Operators: []string{"="}},
+				{Name: "step", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+			Tags: map[string]string{"service": "monitoring", "action": "timeSeries.query"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "query",
+				Description: "The Monitoring Query Language (MQL) query.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("query"),
+			},
+			{
+				Name:        "labels",
+				Description: "The labels of the result time series, e.g. {\"resource.zone\": \"us-central1-a\"}.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "timestamp",
+				Description: "The end time of the point interval.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Point.TimeInterval.EndTime"),
+			},
+			{
+				Name:        "value",
+				Description: "The first value of the point, for INT64 and DOUBLE values.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "values",
+				Description: "All values of the point, by value column name.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "start_time",
+				Description: "The start of the query range, added to the query as a within table operation.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromQual("start_time"),
+			},
+			{
+				Name:        "end_time",
+				Description: "The end of the query range, added to the query as a within table operation.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromQual("end_time"),
+			},
+			{
+				Name:        "step",
+				Description: "The interval between points as an MQL duration (e.g. 5m), added to the query as an every table operation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("step"),
+			},
+
+			// Standard GCP columns
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+			},
+		},
+	}
+}
+
+type monitoringMQLPoint struct {
+	Labels  map[string]interface{}
+	Point   *monitoring.PointData
+	Value   *float64
+	Values  map[string]interface{}
+	Project string
+}
+
+//// LIST FUNCTION
+
+func listMonitoringMQLQuery(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	// Create Service Connection
+	service, err := MonitoringService(ctx, d)
+	if err != nil {
+		logger.Error("gcp_monitoring_mql_query.listMonitoringMQLQuery", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		logger.Error("gcp_monitoring_mql_query.listMonitoringMQLQuery", "cache_error", err)
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// MQL has no range parameters, so the quals are appended to the query as table operations.
+	// Without them the query's own within and every operations, or their defaults, apply.
+	query := d.EqualsQualString("query")
+	if d.EqualsQuals["start_time"] != nil || d.EqualsQuals["end_time"] != nil {
+		startTime, endTime := getMonitoringQueryRange(d)
+		query += fmt.Sprintf(" | within d'%s', d'%s'", startTime.UTC().Format("2006/01/02 15:04:05"), endTime.UTC().Format("2006/01/02 15:04:05"))
+	}
+	if step := d.EqualsQualString("step"); step != "" {
+		query += " | every " + step
+	}
+
+	req := &monitoring.QueryTimeSeriesRequest{
+		Query:    query,
+		PageSize: 1000,
+	}
+
+	resp := service.Projects.TimeSeries.Query("projects/"+project, req)
+	if err := resp.Pages(ctx, func(page *monitoring.QueryTimeSeriesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, partialError := range page.PartialErrors {
+			logger.Warn("gcp_monitoring_mql_query.listMonitoringMQLQuery", "partial_error", partialError.Message)
+		}
+
+		descriptor := page.TimeSeriesDescriptor
+		if descriptor == nil {
+			return nil
+		}
+
+		for _, series := range page.TimeSeriesData {
+			labels := map[string]interface{}{}
+			for i, label := range descriptor.LabelDescriptors {
+				if i < len(series.LabelValues) {
+					labels[label.Key] = mqlLabelValue(label.ValueType, series.LabelValues[i])
+				}
+			}
+
+			for _, point := range series.PointData {
+				values := map[string]interface{}{}
+				var value *float64
+				for i, typedValue := range point.Values {
+					if i < len(descriptor.PointDescriptors) {
+						values[descriptor.PointDescriptors[i].Key] = mqlTypedValue(typedValue)
+					}
+					if i == 0 {
+						value = mqlNumericValue(typedValue)
+					}
+				}
+				d.StreamListItem(ctx, &monitoringMQLPoint{Labels: labels, Point: point, Value: value, Values: values, Project: project})
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		logger.Error("gcp_monitoring_mql_query.listMonitoringMQLQuery", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// mqlLabelValue returns the value of a label of the given LabelDescriptor value type
+func mqlLabelValue(valueType string, value *monitoring.LabelValue) interface{} {
+	switch valueType {
+	case "BOOL":
+		return value.BoolValue
+	case "INT64":
+		return value.Int64Value
+	}
+	return value.StringValue
+}
+
+// mqlTypedValue returns whichever field of a point value is set
+func mqlTypedValue(value *monitoring.TypedValue) interface{} {
+	switch {
+	case value.BoolValue != nil:
+		return *value.BoolValue
+	case value.Int64Value != nil:
+		return *value.Int64Value
+	case value.DoubleValue != nil:
+		return *value.DoubleValue
+	case value.StringValue != nil:
+		return *value.StringValue
+	}
+	return value.DistributionValue
+}
+
+// mqlNumericValue returns an INT64 or DOUBLE point value as a float
+func mqlNumericValue(value *monitoring.TypedValue) *float64 {
+	var number float64
+	switch {
+	case value.DoubleValue != nil:
+		number = *value.DoubleValue
+	case value.Int64Value != nil:
+		number = float64(*value.Int64Value)
+	default:
+		return nil
+	}
+	return &number
+}
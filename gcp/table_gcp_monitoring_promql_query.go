@@ -0,0 +1,211 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	monitoring1 "google.golang.org/api/monitoring/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpMonitoringPromQLQuery(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_monitoring_promql_query",
+		Description: "GCP Monitoring PromQL Query",
+		List: &plugin.ListConfig{
+			Hydrate: listMonitoringPromQLQuery,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "query", Require: plugin.Required, CacheMatch: "exact"},
+				{Name: "start_time", Require: plugin.Optional, Operators: []string{"="}},
+				{Name: "end_time", Require: plugin.Optional, Operators: []string{"="}},
+				{Name: "step", Require: plugin.Optional, CacheMatch: "exact"},
+			},
+			Tags: map[string]string{"service": "monitoring", "action": "prometheus.queryRange"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "query",
+				Description: "The PromQL query, evaluated by the Prometheus HTTP API of Google Cloud Managed Service for Prometheus.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("query"),
+			},
+			{
+				Name:        "metric_name",
+				Description: "The name of the metric, if the query returns one.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Labels.__name__"),
+			},
+			{
+				Name:        "labels",
+				Description: "The labels of the result series.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "timestamp",
+				Description: "The time the value was evaluated at.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "value",
+				Description: "The value of the result series at the timestamp.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "start_time",
+				Description: "The start of the query range. Defaults to an hour before end_time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromQual("start_time"),
+			},
+			{
+				Name:        "end_time",
+				Description: "The end of the query range. Defaults to now.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromQual("end_time"),
+			},
+			{
+				Name:        "step",
+				Description: "The query resolution, as a Prometheus duration (e.g. 5m) or a number of seconds. Defaults to 60s.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("step"),
+			},
+
+			// Standard GCP columns
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+			},
+		},
+	}
+}
+
+type monitoringPromQLPoint struct {
+	Labels    map[string]string
+	Timestamp time.Time
+	Value     float64
+	Project   string
+}
+
+// prometheusQueryRangeData is the `data` of a Prometheus HTTP API range query response
+type prometheusQueryRangeData struct {
+	ResultType string `json:"resultType"`
+	Result     []struct {
+		Metric map[string]string `json:"metric"`
+		Values [][2]interface{}  `json:"values"`
+	} `json:"result"`
+}
+
+//// LIST FUNCTION
+
+func listMonitoringPromQLQuery(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	// Create Service Connection
+	service, err := MonitoringServiceV1(ctx, d)
+	if err != nil {
+		logger.Error("gcp_monitoring_promql_query.listMonitoringPromQLQuery", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		logger.Error("gcp_monitoring_promql_query.listMonitoringPromQLQuery", "cache_error", err)
+		return nil, err
+	}
+	project := projectId.(string)
+
+	startTime, endTime := getMonitoringQueryRange(d)
+	step := d.EqualsQualString("step")
+	if step == "" {
+		step = "60s"
+	}
+
+	req := &monitoring1.QueryRangeRequest{
+		Query: d.EqualsQualString("query"),
+		Start: startTime.Format(time.RFC3339),
+		End:   endTime.Format(time.RFC3339),
+		Step:  step,
+	}
+
+	// apply rate limiting
+	d.WaitForListRateLimit(ctx)
+
+	resp, err := service.Projects.Location.Prometheus.Api.V1.QueryRange("projects/"+project, "global", req).Context(ctx).Do()
+	if err != nil {
+		logger.Error("gcp_monitoring_promql_query.listMonitoringPromQLQuery", "api_error", err)
+		return nil, err
+	}
+
+	// The Prometheus response is decoded into HttpBody as is, so its `data` lands in HttpBody.Data
+	var data prometheusQueryRangeData
+	body, err := json.Marshal(resp.Data)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, err
+	}
+	if data.ResultType != "" && data.ResultType != "matrix" {
+		return nil, fmt.Errorf("unexpected %s result for range query", data.ResultType)
+	}
+
+	for _, series := range data.Result {
+		for _, sample := range series.Values {
+			timestamp, value, err := parsePrometheusSample(sample)
+			if err != nil {
+				return nil, err
+			}
+			d.StreamListItem(ctx, &monitoringPromQLPoint{Labels: series.Metric, Timestamp: timestamp, Value: value, Project: project})
+
+			// Check if context has been cancelled or if the limit has been hit (if specified)
+			// if there is a limit, it will return the number of rows required to reach this limit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// getMonitoringQueryRange returns the range to evaluate a query over, from the `start_time` and
+// `end_time` quals. The range defaults to the hour before end_time, which defaults to now.
+func getMonitoringQueryRange(d *plugin.QueryData) (time.Time, time.Time) {
+	endTime := time.Now()
+	if d.EqualsQuals["end_time"] != nil {
+		endTime = d.EqualsQuals["end_time"].GetTimestampValue().AsTime()
+	}
+	startTime := endTime.Add(-time.Hour)
+	if d.EqualsQuals["start_time"] != nil {
+		startTime = d.EqualsQuals["start_time"].GetTimestampValue().AsTime()
+	}
+	return startTime, endTime
+}
+
+// parsePrometheusSample parses a [<unix time>, "<value>"] sample of a Prometheus range query
+func parsePrometheusSample(sample [2]interface{}) (time.Time, float64, error) {
+	unixTime, ok := sample[0].(float64)
+	if !ok {
+		return time.Time{}, 0, fmt.Errorf("unexpected sample time %v", sample[0])
+	}
+	valueString, ok := sample[1].(string)
+	if !ok {
+		return time.Time{}, 0, fmt.Errorf("unexpected sample value %v", sample[1])
+	}
+	value, err := strconv.ParseFloat(valueString, 64)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+
+	seconds, fraction := math.Modf(unixTime)
+	return time.Unix(int64(seconds), int64(fraction*1e9)).UTC(), value, nil
+}
@@ -0,0 +1,270 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/monitoring/v3"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpMonitoringTimeSeries(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_monitoring_time_series",
+		Description: "GCP Monitoring Time Series",
+		List: &plugin.ListConfig{
+			Hydrate: listMonitoringTimeSeries,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "metric_type", Require: plugin.Required, Operators: []string{"="}},
+				{Name: "filter", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "aligner", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "reducer", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "group_by", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "period", Require: plugin.Optional, CacheMatch: "exact"},
+				{Name: "timestamp", Require: plugin.Optional, Operators: []string{"=", ">", "<", ">=", "<="}},
+			},
+			Tags: map[string]string{"service": "monitoring", "action": "timeSeries.list"},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "metric_type",
+				Description: "The type of the metric, e.g. compute.googleapis.com/instance/cpu/utilization.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TimeSeries.Metric.Type"),
+			},
+			{
+				Name:        "timestamp",
+				Description: "The end time of the data point interval.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Point.Interval.EndTime"),
+			},
+			{
+				Name:        "start_time",
+				Description: "The start time of the data point interval. Equal to the end time for GAUGE metrics.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Point.Interval.StartTime"),
+			},
+			{
+				Name:        "value",
+				Description: "The value of the data point, for INT64 and DOUBLE metrics.",
+				Type:        proto.ColumnType_DOUBLE,
+				Transform:   transform.From(monitoringTimeSeriesPointValue),
+			},
+			{
+				Name:        "bool_value",
+				Description: "The value of the data point, for BOOL metrics.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Point.Value.BoolValue"),
+			},
+			{
+				Name:        "string_value",
+				Description: "The value of the data point, for STRING metrics.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Point.Value.StringValue"),
+			},
+			{
+				Name:        "distribution_value",
+				Description: "The value of the data point, for DISTRIBUTION metrics.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Point.Value.DistributionValue"),
+			},
+			{
+				Name:        "metric_kind",
+				Description: "The metric kind of the time series, i.e. GAUGE, DELTA or CUMULATIVE.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TimeSeries.MetricKind"),
+			},
+			{
+				Name:        "value_type",
+				Description: "The value type of the time series, i.e. BOOL, INT64, DOUBLE, STRING or DISTRIBUTION.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TimeSeries.ValueType"),
+			},
+			{
+				Name:        "unit",
+				Description: "The units in which the metric value is reported.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TimeSeries.Unit"),
+			},
+			{
+				Name:        "metric_labels",
+				Description: "The set of label values that uniquely identify this metric.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TimeSeries.Metric.Labels"),
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the monitored resource, e.g. gce_instance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TimeSeries.Resource.Type"),
+			},
+			{
+				Name:        "resource_labels",
+				Description: "The set of label values that identify the monitored resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TimeSeries.Resource.Labels"),
+			},
+			{
+				Name:        "metadata",
+				Description: "The associated monitored resource metadata.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TimeSeries.Metadata"),
+			},
+			{
+				Name:        "filter",
+				Description: "An additional monitoring filter (https://cloud.google.com/monitoring/api/v3/filters) ANDed with the metric type, e.g. resource.labels.zone = \"us-central1-a\".",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("filter"),
+			},
+			{
+				Name:        "aligner",
+				Description: "The per series aligner applied to each time series, e.g. ALIGN_MEAN.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("aligner"),
+			},
+			{
+				Name:        "reducer",
+				Description: "The cross series reducer combining the aligned time series, e.g. REDUCE_SUM.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("reducer"),
+			},
+			{
+				Name:        "group_by",
+				Description: "The fields preserved when the reducer is applied, e.g. [\"resource.labels.zone\"].",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromQual("group_by"),
+			},
+			{
+				Name:        "period",
+				Description: "The alignment period in seconds. Defaults to 60 when an aligner or reducer is set.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromQual("period"),
+			},
+
+			// Standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TimeSeries.Metric.Type"),
+			},
+
+			// Standard GCP columns
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+			},
+		},
+	}
+}
+
+type monitoringTimeSeriesPoint struct {
+	TimeSeries *monitoring.TimeSeries
+	Point      *monitoring.Point
+	Project    string
+}
+
+//// LIST FUNCTION
+
+func listMonitoringTimeSeries(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	// Create Service Connection
+	service, err := MonitoringService(ctx, d)
+	if err != nil {
+		logger.Error("gcp_monitoring_time_series.listMonitoringTimeSeries", "service_error", err)
+		return nil, err
+	}
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		logger.Error("gcp_monitoring_time_series.listMonitoringTimeSeries", "cache_error", err)
+		return nil, err
+	}
+	project := projectId.(string)
+
+	filterString := "metric.type = " + quoteFilterValue(d.EqualsQualString("metric_type"))
+	if filter := d.EqualsQualString("filter"); filter != "" {
+		filterString += " AND (" + filter + ")"
+	}
+
+	// Default to the last hour, the same window the console opens metrics explorer with
+	startTime, endTime := getMonitoringIntervalFromQuals(d.Quals, time.Now().Add(-time.Hour))
+
+	resp := service.Projects.TimeSeries.List("projects/" + project).
+		Filter(filterString).
+		IntervalStartTime(startTime.Format(time.RFC3339)).
+		IntervalEndTime(endTime.Format(time.RFC3339))
+
+	aligner := d.EqualsQualString("aligner")
+	reducer := d.EqualsQualString("reducer")
+	if aligner != "" {
+		resp.AggregationPerSeriesAligner(aligner)
+	}
+	if reducer != "" {
+		resp.AggregationCrossSeriesReducer(reducer)
+	}
+	if d.EqualsQuals["group_by"] != nil {
+		var groupBy []string
+		if err := json.Unmarshal([]byte(d.EqualsQuals["group_by"].GetJsonbValue()), &groupBy); err != nil {
+			return nil, fmt.Errorf("group_by must be a JSON array of strings, e.g. '[\"resource.labels.zone\"]': %v", err)
+		}
+		resp.AggregationGroupByFields(groupBy...)
+	}
+
+	// The API rejects an aggregation without an alignment period
+	if d.EqualsQuals["period"] != nil {
+		resp.AggregationAlignmentPeriod(fmt.Sprintf("%ds", d.EqualsQuals["period"].GetInt64Value()))
+	} else if aligner != "" || reducer != "" {
+		resp.AggregationAlignmentPeriod("60s")
+	}
+
+	if err := resp.Pages(ctx, func(page *monitoring.ListTimeSeriesResponse) error {
+		// apply rate limiting
+		d.WaitForListRateLimit(ctx)
+
+		for _, timeSeries := range page.TimeSeries {
+			for _, point := range timeSeries.Points {
+				d.StreamListItem(ctx, &monitoringTimeSeriesPoint{TimeSeries: timeSeries, Point: point, Project: project})
+
+				// Check if context has been cancelled or if the limit has been hit (if specified)
+				// if there is a limit, it will return the number of rows required to reach this limit
+				if d.RowsRemaining(ctx) == 0 {
+					page.NextPageToken = ""
+					return nil
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		logger.Error("gcp_monitoring_time_series.listMonitoringTimeSeries", "api_error", err)
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func monitoringTimeSeriesPointValue(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	value := d.HydrateItem.(*monitoringTimeSeriesPoint).Point.Value
+	if value == nil {
+		return nil, nil
+	}
+
+	switch {
+	case value.DoubleValue != nil:
+		return *value.DoubleValue, nil
+	case value.Int64Value != nil:
+		return float64(*value.Int64Value), nil
+	}
+	return nil, nil
+}
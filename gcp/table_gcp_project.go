@@ -20,6 +20,7 @@ func tableGcpProject(_ context.Context) *plugin.Table {
 			Hydrate: listGCPProjects,
 			Tags:    map[string]string{"service": "resourcemanager", "action": "projects.list"},
 		},
+		GetMatrixItemFunc: BuildProjectList,
 		HydrateConfig: []plugin.HydrateConfig{
 			{
 				Func: getProjectAccessApprovalSettings,
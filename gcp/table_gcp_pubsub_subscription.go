@@ -278,7 +278,7 @@ func getPubSubSubscriptionIamPolicy(ctx context.Context, d *plugin.QueryData, h
 	}
 
 	resource := h.Item.(*pubsub.Subscription)
-	req, err := service.Projects.Subscriptions.GetIamPolicy(resource.Name).Do()
+	req, err := service.Projects.Subscriptions.GetIamPolicy(resource.Name).OptionsRequestedPolicyVersion(3).Do()
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,52 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/pubsub/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpPubSubSubscriptionIamBinding(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_pubsub_subscription_iam_binding",
+		Description: "GCP Pub/Sub Subscription IAM Binding",
+		List: &plugin.ListConfig{
+			ParentHydrate: listPubSubSubscription,
+			Hydrate:       listPubSubSubscriptionIamBindings,
+			Tags:          map[string]string{"service": "pubsub", "action": "subscriptions.getIamPolicy"},
+		},
+		Columns: iamBindingColumns([]*plugin.Column{
+			{
+				Name:        "subscription_name",
+				Description: "The name of the subscription.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listPubSubSubscriptionIamBindings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	subscription := h.Item.(*pubsub.Subscription)
+
+	// apply rate limiting
+	d.WaitForListRateLimit(ctx)
+
+	policy, err := getPubSubSubscriptionIamPolicy(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_pubsub_subscription_iam_binding.listPubSubSubscriptionIamBindings", "api_error", err)
+		return nil, err
+	}
+
+	_, err = streamIamPolicyBindings(ctx, d, policy, getLastPathElement(subscription.Name), "global", strings.Split(subscription.Name, "/")[1])
+	return nil, err
+}
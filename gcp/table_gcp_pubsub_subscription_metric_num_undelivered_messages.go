@@ -0,0 +1,44 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/pubsub/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpPubSubSubscriptionMetricNumUndeliveredMessages(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_pubsub_subscription_metric_num_undelivered_messages",
+		Description: "GCP Pub/Sub Subscription Metrics - Number of Undelivered Messages",
+		List: &plugin.ListConfig{
+			ParentHydrate: listPubSubSubscription,
+			Hydrate:       listPubSubSubscriptionMetricNumUndeliveredMessages,
+			KeyColumns:    monitoringMetricKeyColumns(nil),
+			Tags:          map[string]string{"service": "monitoring", "action": "timeSeries.list"},
+		},
+		Columns: monitoringMetricColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the subscription.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DimensionValue"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listPubSubSubscriptionMetricNumUndeliveredMessages(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	subscriptionInfo := h.Item.(*pubsub.Subscription)
+
+	subscriptionName := getLastPathElement(subscriptionInfo.Name)
+	dimensionValue := "\"" + subscriptionName + "\""
+
+	return listMonitorMetricStatistics(ctx, d, h, "FIVE_MINUTES", "\"pubsub.googleapis.com/subscription/num_undelivered_messages\"", "resource.label.subscription_id = ", dimensionValue, subscriptionName, "global")
+}
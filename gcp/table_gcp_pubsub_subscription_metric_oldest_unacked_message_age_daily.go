@@ -0,0 +1,44 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/pubsub/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpPubSubSubscriptionMetricOldestUnackedMessageAgeDaily(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_pubsub_subscription_metric_oldest_unacked_message_age_daily",
+		Description: "GCP Pub/Sub Subscription Metrics - Oldest Unacked Message Age (Daily)",
+		List: &plugin.ListConfig{
+			ParentHydrate: listPubSubSubscription,
+			Hydrate:       listPubSubSubscriptionMetricOldestUnackedMessageAgeDaily,
+			KeyColumns:    monitoringMetricKeyColumns(nil),
+			Tags:          map[string]string{"service": "monitoring", "action": "timeSeries.list"},
+		},
+		Columns: monitoringMetricColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the subscription.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DimensionValue"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listPubSubSubscriptionMetricOldestUnackedMessageAgeDaily(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	subscriptionInfo := h.Item.(*pubsub.Subscription)
+
+	subscriptionName := getLastPathElement(subscriptionInfo.Name)
+	dimensionValue := "\"" + subscriptionName + "\""
+
+	return listMonitorMetricStatistics(ctx, d, h, "DAILY", "\"pubsub.googleapis.com/subscription/oldest_unacked_message_age\"", "resource.label.subscription_id = ", dimensionValue, subscriptionName, "global")
+}
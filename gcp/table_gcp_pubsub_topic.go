@@ -62,6 +62,20 @@ func tableGcpPubSubTopic(ctx context.Context) *plugin.Table {
 				Hydrate:     getPubSubTopicIamPolicy,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "is_public",
+				Description: "True if the IAM policy grants any role to allUsers or allAuthenticatedUsers.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getPubSubTopicIamPolicy,
+				Transform:   transform.FromValue().Transform(iamPolicyIsPublic),
+			},
+			{
+				Name:        "public_roles",
+				Description: "The roles the IAM policy grants to allUsers, allAuthenticatedUsers or a whole domain, with the member each is granted to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getPubSubTopicIamPolicy,
+				Transform:   transform.FromValue().Transform(iamPolicyPublicRoles),
+			},
 			{
 				Name:        "labels",
 				Description: "A set of labels attached with the topic.",
@@ -199,7 +213,7 @@ func getPubSubTopicIamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin
 
 	topic := h.Item.(*pubsub.Topic)
 
-	req, err := service.Projects.Topics.GetIamPolicy(topic.Name).Do()
+	req, err := service.Projects.Topics.GetIamPolicy(topic.Name).OptionsRequestedPolicyVersion(3).Do()
 	if err != nil {
 		// Return nil, if the resource not present
 		result := isIgnorableError([]string{"404"})
@@ -0,0 +1,52 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/pubsub/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpPubSubTopicIamBinding(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_pubsub_topic_iam_binding",
+		Description: "GCP Pub/Sub Topic IAM Binding",
+		List: &plugin.ListConfig{
+			ParentHydrate: listPubSubTopics,
+			Hydrate:       listPubSubTopicIamBindings,
+			Tags:          map[string]string{"service": "pubsub", "action": "topics.getIamPolicy"},
+		},
+		Columns: iamBindingColumns([]*plugin.Column{
+			{
+				Name:        "topic_name",
+				Description: "The name of the topic.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listPubSubTopicIamBindings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	topic := h.Item.(*pubsub.Topic)
+
+	// apply rate limiting
+	d.WaitForListRateLimit(ctx)
+
+	policy, err := getPubSubTopicIamPolicy(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_pubsub_topic_iam_binding.listPubSubTopicIamBindings", "api_error", err)
+		return nil, err
+	}
+
+	_, err = streamIamPolicyBindings(ctx, d, policy, getLastPathElement(topic.Name), "global", strings.Split(topic.Name, "/")[1])
+	return nil, err
+}
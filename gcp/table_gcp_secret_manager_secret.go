@@ -18,10 +18,18 @@ func tableGcpSecretManagerSecret(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("name"),
 			Hydrate:    getGcpSecretManagerSecret,
-			Tags: map[string]string{"service": "secretmanager", "action": "secrets.get"},
+			Tags:       map[string]string{"service": "secretmanager", "action": "secrets.get"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listGcpSecretManagerSecrets,
+			KeyColumns: plugin.KeyColumnSlice{
+				// Timestamp columns
+				{Name: "create_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
+				{Name: "expire_time", Require: plugin.Optional, Operators: []string{">", ">=", "<", "<=", "="}},
+
+				// JSON columns
+				{Name: "labels", Require: plugin.Optional, Operators: []string{"@>", "?"}},
+			},
 			Tags: map[string]string{"service": "secretmanager", "action": "secrets.list"},
 		},
 		Columns: []*plugin.Column{
@@ -129,7 +137,15 @@ func listGcpSecretManagerSecrets(ctx context.Context, d *plugin.QueryData, h *pl
 	}
 	project := projectId.(string)
 
-	resp := service.Projects.Secrets.List("projects/" + project).PageSize(pageSize)
+	// https://cloud.google.com/secret-manager/docs/filtering
+	filterQuals := []filterQualMap{
+		{"create_time", "create_time", "timestamp"},
+		{"expire_time", "expire_time", "timestamp"},
+		{"labels", "labels", "labels"},
+	}
+	filterString := buildAIP160Filter(filterQuals, d.Quals)
+
+	resp := service.Projects.Secrets.List("projects/" + project).PageSize(pageSize).Filter(filterString)
 	if err := resp.Pages(ctx, func(page *secretmanager.ListSecretsResponse) error {
 		// apply rate limiting
 		d.WaitForListRateLimit(ctx)
@@ -194,6 +194,20 @@ func tableGcpStorageBucket(_ context.Context) *plugin.Table {
 				Hydrate:     getGcpStorageBucketIAMPolicy,
 				Transform:   transform.FromValue(),
 			},
+			{
+				Name:        "is_public",
+				Description: "True if the IAM policy grants any role to allUsers or allAuthenticatedUsers.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getGcpStorageBucketIAMPolicy,
+				Transform:   transform.FromValue().Transform(iamPolicyIsPublic),
+			},
+			{
+				Name:        "public_roles",
+				Description: "The roles the IAM policy grants to allUsers, allAuthenticatedUsers or a whole domain, with the member each is granted to.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getGcpStorageBucketIAMPolicy,
+				Transform:   transform.FromValue().Transform(iamPolicyPublicRoles),
+			},
 			{
 				Name:        "lifecycle_rules",
 				Description: "The bucket's lifecycle configuration. See lifecycle management for more information.",
@@ -326,7 +340,7 @@ func getGcpStorageBucketIAMPolicy(ctx context.Context, d *plugin.QueryData, h *p
 		return nil, err
 	}
 
-	resp, err := service.Buckets.GetIamPolicy(bucket.Name).Do()
+	resp, err := service.Buckets.GetIamPolicy(bucket.Name).OptionsRequestedPolicyVersion(3).Do()
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,58 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+
+	"google.golang.org/api/storage/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpStorageBucketIamBinding(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_storage_bucket_iam_binding",
+		Description: "GCP Storage Bucket IAM Binding",
+		List: &plugin.ListConfig{
+			ParentHydrate: listGcpStorageBuckets,
+			Hydrate:       listStorageBucketIamBindings,
+			Tags:          map[string]string{"service": "storage", "action": "buckets.getIamPolicy"},
+		},
+		Columns: iamBindingColumns([]*plugin.Column{
+			{
+				Name:        "bucket_name",
+				Description: "The name of the bucket.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ResourceName"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listStorageBucketIamBindings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucket := h.Item.(*storage.Bucket)
+
+	// Get project details
+	projectId, err := getProject(ctx, d, h)
+	if err != nil {
+		return nil, err
+	}
+	project := projectId.(string)
+
+	// apply rate limiting
+	d.WaitForListRateLimit(ctx)
+
+	policy, err := getGcpStorageBucketIAMPolicy(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("gcp_storage_bucket_iam_binding.listStorageBucketIamBindings", "api_error", err)
+		return nil, err
+	}
+
+	_, err = streamIamPolicyBindings(ctx, d, policy, bucket.Name, bucket.Location, project)
+	return nil, err
+}
@@ -0,0 +1,43 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/api/storage/v1"
+)
+
+//// TABLE DEFINITION
+
+func tableGcpStorageBucketMetricTotalBytesHourly(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_storage_bucket_metric_total_bytes_hourly",
+		Description: "GCP Storage Bucket Metrics - Total Bytes (Hourly)",
+		List: &plugin.ListConfig{
+			ParentHydrate: listGcpStorageBuckets,
+			Hydrate:       listStorageBucketMetricTotalBytesHourly,
+			KeyColumns:    monitoringMetricKeyColumns(nil),
+			Tags:          map[string]string{"service": "monitoring", "action": "timeSeries.list"},
+		},
+		Columns: monitoringMetricColumns([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the bucket.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DimensionValue"),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listStorageBucketMetricTotalBytesHourly(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	bucketInfo := h.Item.(*storage.Bucket)
+
+	dimensionValue := "\"" + bucketInfo.Name + "\""
+
+	return listMonitorMetricStatistics(ctx, d, h, "HOURLY", "\"storage.googleapis.com/storage/total_bytes\"", "resource.label.bucket_name = ", dimensionValue, bucketInfo.Name, bucketInfo.Location)
+}
@@ -3,7 +3,6 @@ package gcp
 import (
 	"context"
 
-	resourcemanager "cloud.google.com/go/resourcemanager/apiv3"
 	resourcemanagerpb "cloud.google.com/go/resourcemanager/apiv3/resourcemanagerpb"
 	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
@@ -81,8 +80,9 @@ func listGcpTagBindings(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	}
 
 	// Create Service Connection
-	client, err := resourcemanager.NewTagBindingsClient(ctx)
+	client, err := TagBindingsService(ctx, d)
 	if err != nil {
+		plugin.Logger(ctx).Error("gcp_tag_binding.listGcpTagBindings", "service_error", err)
 		return nil, err
 	}
 
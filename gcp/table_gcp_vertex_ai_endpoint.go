@@ -30,6 +30,12 @@ func tableGcpVertexAIEndpoint(_ context.Context) *plugin.Table {
 			Hydrate:           listAIPlatformEndpoints,
 			ShouldIgnoreError: isIgnorableError([]string{"Unimplemented"}),
 			Tags:              map[string]string{"service": "aiplatform", "action": "endpoints.list"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "display_name", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+
+				// JSON columns
+				{Name: "labels", Require: plugin.Optional, Operators: []string{"@>", "?"}},
+			},
 		},
 		GetMatrixItemFunc: BuildVertexAILocationListByClientType("Endpoint"),
 		Columns: []*plugin.Column{
@@ -160,13 +166,7 @@ func listAIPlatformEndpoints(ctx context.Context, d *plugin.QueryData, h *plugin
 		location = matrixLocation
 	}
 
-	// Minimize API call as per given location
-	if region != "" && region != location {
-		return nil, nil
-	}
-
 	// Get project details
-
 	projectId, err := getProject(ctx, d, h)
 	if err != nil {
 		logger.Error("gcp_vertex_ai_endpoint.listAIPlatformEndpoints", "cache_error", err)
@@ -174,6 +174,11 @@ func listAIPlatformEndpoints(ctx context.Context, d *plugin.QueryData, h *plugin
 	}
 	project := projectId.(string)
 
+	// Minimize API call as per given location
+	if region != "" && region != location {
+		return nil, nil
+	}
+
 	// Page size should be in range of [0, 100].
 	pageSize := types.Int64(100)
 	limit := d.QueryContext.Limit
@@ -190,9 +195,15 @@ func listAIPlatformEndpoints(ctx context.Context, d *plugin.QueryData, h *plugin
 		return nil, err
 	}
 
+	filterQuals := []filterQualMap{
+		{"display_name", "display_name", "string"},
+		{"labels", "labels", "labels"},
+	}
+
 	input := &aiplatformpb.ListEndpointsRequest{
 		Parent:   "projects/" + project + "/locations/" + location,
 		PageSize: int32(*pageSize),
+		Filter:   buildAIP160Filter(filterQuals, d.Quals),
 	}
 
 	it := service.Endpoint.ListEndpoints(ctx, input)
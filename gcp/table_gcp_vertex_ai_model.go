@@ -27,6 +27,12 @@ func tableGcpVertexAIModel(ctx context.Context) *plugin.Table {
 			Hydrate:           listAIPlatformModels,
 			ShouldIgnoreError: isIgnorableError([]string{"Unauthenticated", "Unimplemented", "InvalidArgument"}),
 			Tags:              map[string]string{"service": "aiplatform", "action": "models.list"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "display_name", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+
+				// JSON columns
+				{Name: "labels", Require: plugin.Optional, Operators: []string{"@>", "?"}},
+			},
 		},
 		GetMatrixItemFunc: BuildVertexAILocationListByClientType("Model"),
 		Columns: []*plugin.Column{
@@ -229,14 +235,7 @@ func listAIPlatformModels(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 		location = matrixLocation
 	}
 
-	// Minimize API call as per given location
-	if region != "" && region != location {
-		logger.Warn("gcp_vertex_ai_model.listAIPlatformModels", "location", region, "matrixLocation", location)
-		return nil, nil
-	}
-
 	// Get project details
-
 	projectId, err := getProject(ctx, d, h)
 	if err != nil {
 		logger.Error("gcp_vertex_ai_model.listAIPlatformModels", "cache_error", err)
@@ -245,6 +244,12 @@ func listAIPlatformModels(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 
 	project := projectId.(string)
 
+	// Minimize API call as per given location
+	if region != "" && region != location {
+		logger.Warn("gcp_vertex_ai_model.listAIPlatformModels", "location", region, "matrixLocation", location)
+		return nil, nil
+	}
+
 	// Page size should be in range of [0, 100].
 	pageSize := types.Int64(100)
 	limit := d.QueryContext.Limit
@@ -261,9 +266,15 @@ func listAIPlatformModels(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 		return nil, err
 	}
 
+	filterQuals := []filterQualMap{
+		{"display_name", "display_name", "string"},
+		{"labels", "labels", "labels"},
+	}
+
 	req := &aiplatformpb.ListModelsRequest{
 		Parent:   "projects/" + project + "/locations/" + location,
 		PageSize: int32(*pageSize),
+		Filter:   buildAIP160Filter(filterQuals, d.Quals),
 	}
 
 	it := service.Model.ListModels(ctx, req)
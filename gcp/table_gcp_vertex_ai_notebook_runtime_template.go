@@ -26,6 +26,9 @@ func tableGcpVertexAINotebookRuntimeTemplate(ctx context.Context) *plugin.Table
 			Hydrate:           listAIPlatformNotebookRuntimeTemplates,
 			ShouldIgnoreError: isIgnorableError([]string{"Unauthenticated", "Unimplemented", "InvalidArgument"}),
 			Tags:              map[string]string{"service": "aiplatform", "action": "notebookRuntimeTemplates.list"},
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "display_name", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+			},
 		},
 		GetMatrixItemFunc: BuildVertexAILocationListByClientType("Notebook"),
 		Columns: []*plugin.Column{
@@ -163,12 +166,6 @@ func listAIPlatformNotebookRuntimeTemplates(ctx context.Context, d *plugin.Query
 		location = matrixLocation
 	}
 
-	// Minimize API call as per given location
-	if region != "" && region != location {
-		logger.Warn("gcp_vertex_ai_notebook_runtime_template.listAIPlatformNotebookRuntimeTemplates", "location", region, "matrixLocation", location)
-		return nil, nil
-	}
-
 	// Get project details
 	projectId, err := getProject(ctx, d, h)
 	if err != nil {
@@ -176,6 +173,12 @@ func listAIPlatformNotebookRuntimeTemplates(ctx context.Context, d *plugin.Query
 	}
 	project := projectId.(string)
 
+	// Minimize API call as per given location
+	if region != "" && region != location {
+		logger.Warn("gcp_vertex_ai_notebook_runtime_template.listAIPlatformNotebookRuntimeTemplates", "location", region, "matrixLocation", location)
+		return nil, nil
+	}
+
 	// Create Service Connection
 	service, err := AIService(ctx, d, "Notebook")
 	if err != nil {
@@ -193,9 +196,14 @@ func listAIPlatformNotebookRuntimeTemplates(ctx context.Context, d *plugin.Query
 		}
 	}
 
+	filterQuals := []filterQualMap{
+		{"display_name", "displayName", "string"},
+	}
+
 	req := &aiplatformpb.ListNotebookRuntimeTemplatesRequest{
 		Parent:   "projects/" + project + "/locations/" + location,
 		PageSize: pageSize,
+		Filter:   buildAIP160Filter(filterQuals, d.Quals),
 	}
 
 	// Call the API
@@ -234,6 +242,10 @@ func getAIPlatformNotebookRuntimeTemplate(ctx context.Context, d *plugin.QueryDa
 	name := d.EqualsQualString("name")
 	splitName := strings.Split(name, "/")
 
+	if err := getMatrixLocationError(ctx, d); err != nil {
+		return nil, err
+	}
+
 	// Validate - name should not be blank and restrict the API call for other locations
 	if len(name) > 3 && splitName[3] != matrixLocation {
 		return nil, nil
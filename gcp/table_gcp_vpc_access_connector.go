@@ -188,6 +188,10 @@ func getVPCAccessConnector(ctx context.Context, d *plugin.QueryData, h *plugin.H
 		return nil, nil
 	}
 
+	if err := getMatrixLocationError(ctx, d); err != nil {
+		return nil, err
+	}
+
 	// Restrict the API call for other locations
 	if len(strings.Split(name, "/")) > 2 && strings.Split(name, "/")[3] != matrixLocation {
 		return nil, nil
@@ -4,11 +4,18 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
 	"os/exec"
+	"path"
+	"regexp"
 	"runtime"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	"github.com/mitchellh/go-homedir"
@@ -18,8 +25,12 @@ import (
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google/externalaccount"
 	"google.golang.org/api/impersonate"
 	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func getLastPathElement(path string) string {
@@ -61,6 +72,16 @@ func getProjectCacheKey(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 }
 
 func getProject(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (any, error) {
+	// The matrix builders record a failed project or location lookup as a matrix item
+	if err := getMatrixLocationError(ctx, d); err != nil {
+		return nil, err
+	}
+
+	// Tables are fanned out over the project matrix, so prefer the project of the current matrix item
+	if project := d.EqualsQualString(matrixKeyProject); project != "" {
+		return project, nil
+	}
+
 	projectId, err := getProjectMemoized(ctx, d, h)
 	if err != nil {
 		return nil, err
@@ -172,6 +193,23 @@ func getProjectFromConfig(connection *plugin.Connection) string {
 	return ""
 }
 
+// isLocationIncluded checks a region, zone or location name against the `locations` config argument.
+// Entries may contain wildcards (e.g. "us-*"). All locations are included if the argument is not set,
+// and the "global" location is always included.
+func isLocationIncluded(connection *plugin.Connection, location string) bool {
+	gcpConfig := GetConfig(connection)
+	if len(gcpConfig.Locations) == 0 || location == "global" {
+		return true
+	}
+
+	for _, pattern := range gcpConfig.Locations {
+		if ok, _ := path.Match(pattern, location); ok {
+			return true
+		}
+	}
+	return false
+}
+
 func base64DecodedData(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	data, err := base64.StdEncoding.DecodeString(types.SafeString(d.Value))
 	// check if CorruptInputError or invalid UTF-8
@@ -184,17 +222,41 @@ func base64DecodedData(_ context.Context, d *transform.TransformData) (interface
 }
 
 // Set project values from config and return client options
-func setSessionConfig(ctx context.Context, connection *plugin.Connection) []option.ClientOption {
+func setSessionConfig(ctx context.Context, connection *plugin.Connection) ([]option.ClientOption, error) {
 	gcpConfig := GetConfig(connection)
 	opts := []option.ClientOption{}
 
+	// Clients in a sovereign or Trusted Partner Cloud universe use its domain for every endpoint
+	universeDomainOpts := []option.ClientOption{}
+	if gcpConfig.UniverseDomain != nil {
+		universeDomainOpts = append(universeDomainOpts, option.WithUniverseDomain(*gcpConfig.UniverseDomain))
+	}
+
+	// Local emulators such as the Pub/Sub emulator do not accept credentials
+	if gcpConfig.WithoutAuthentication != nil && *gcpConfig.WithoutAuthentication {
+		return append(universeDomainOpts, option.WithoutAuthentication()), nil
+	}
+
 	if gcpConfig.Credentials != nil {
 		contents, err := pathOrContents(*gcpConfig.Credentials)
 		if err != nil {
-			panic(err)
+			return nil, sessionConfigError(connection, "credentials", err)
 		}
 		opts = append(opts, option.WithCredentialsJSON([]byte(contents)))
 	}
+
+	if gcpConfig.ExternalAccountAudience != nil {
+		// Both would be passed to the client as competing credential sources
+		if gcpConfig.Credentials != nil {
+			return nil, sessionConfigError(connection, "external_account_audience", fmt.Errorf("credentials and external_account_audience cannot both be set"))
+		}
+		ts, err := externalAccountTokenSource(gcpConfig)
+		if err != nil {
+			return nil, sessionConfigError(connection, "external_account_audience", err)
+		}
+		opts = append(opts, option.WithTokenSource(ts))
+	}
+
 	if gcpConfig.ImpersonateAccessToken != nil {
 		tokenConfig := oauth2.Token{
 			AccessToken: *gcpConfig.ImpersonateAccessToken,
@@ -204,30 +266,205 @@ func setSessionConfig(ctx context.Context, connection *plugin.Connection) []opti
 	}
 
 	if gcpConfig.ImpersonateServiceAccount != nil {
-		ts, err := impersonate.CredentialsTokenSource(ctx, impersonate.CredentialsConfig{
+		// The credentials configured above are the base identity that impersonates
+		// the target service account, optionally through a chain of delegates.
+		// Like the external account token source, it is cached with the service
+		// clients, so it must not be bound to the query context.
+		ts, err := impersonate.CredentialsTokenSource(context.Background(), impersonate.CredentialsConfig{
 			TargetPrincipal: *gcpConfig.ImpersonateServiceAccount,
+			Delegates:       gcpConfig.Delegates,
 			Scopes:          []string{"https://www.googleapis.com/auth/cloud-platform"},
-		})
+		}, append(opts, universeDomainOpts...)...)
 		if err != nil {
-			panic(err)
+			return nil, sessionConfigError(connection, "impersonate_service_account", err)
 		}
 
-		opts = append(opts, option.WithTokenSource(ts))
+		opts = []option.ClientOption{option.WithTokenSource(ts)}
 	}
+	opts = append(opts, universeDomainOpts...)
 
-	// check if quota project is set via env var
-	quotaProject := os.Getenv("GOOGLE_CLOUD_QUOTA_PROJECT")
+	if quotaProject := getQuotaProject(gcpConfig); quotaProject != "" {
+		opts = append(opts, option.WithQuotaProject(quotaProject))
+	}
 
+	return opts, nil
+}
+
+// getQuotaProject returns the project API calls are billed and rate limited against, if one is set
+func getQuotaProject(gcpConfig gcpConfig) string {
 	// check if quota project is set in config
 	if gcpConfig.QuotaProject != nil {
-		quotaProject = *gcpConfig.QuotaProject
+		return *gcpConfig.QuotaProject
 	}
 
-	if quotaProject != "" {
-		opts = append(opts, option.WithQuotaProject(quotaProject))
+	// check if quota project is set via env var
+	return os.Getenv("GOOGLE_CLOUD_QUOTA_PROJECT")
+}
+
+// getUniverseDomain returns the universe domain the connection's API endpoints belong to
+func getUniverseDomain(gcpConfig gcpConfig) string {
+	if gcpConfig.UniverseDomain != nil {
+		return *gcpConfig.UniverseDomain
+	}
+	if universeDomain := os.Getenv("GOOGLE_CLOUD_UNIVERSE_DOMAIN"); universeDomain != "" {
+		return universeDomain
 	}
+	return "googleapis.com"
+}
 
-	return opts
+// setGRPCSessionConfig returns the client options for a gRPC client, including the endpoint
+// configured for the service in the `endpoints` config argument. An "http://" endpoint is
+// dialled without TLS, as local emulators expect.
+func setGRPCSessionConfig(ctx context.Context, d *plugin.QueryData, serviceName string) ([]option.ClientOption, error) {
+	// The ignore error predicate cannot return an error, so invalid patterns are reported here
+	if _, err := ignoreErrorMessageRegexps(d); err != nil {
+		return nil, err
+	}
+
+	opts, err := setSessionConfig(ctx, d.Connection)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, ok := GetConfig(d.Connection).Endpoints[serviceName]
+	if !ok {
+		return opts, nil
+	}
+
+	if host, found := strings.CutPrefix(endpoint, "http://"); found {
+		opts = append(opts, option.WithEndpoint(strings.TrimSuffix(host, "/")), option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())))
+	} else {
+		opts = append(opts, option.WithEndpoint(strings.TrimSuffix(strings.TrimPrefix(endpoint, "https://"), "/")))
+	}
+
+	return opts, nil
+}
+
+// newRESTService creates a REST client of a generated google.golang.org/api package with the
+// connection's client options. Its requests are retried with the connection's retry policy,
+// see retryTransport. If the `endpoints` config argument has an entry for serviceName, the
+// client is pointed at it with option.WithEndpoint. apiPath is the path of the client's
+// default base path (e.g. "compute/v1/"), which is kept if the configured endpoint has none.
+func newRESTService[S any](ctx context.Context, d *plugin.QueryData, serviceName string, apiPath string, newService func(context.Context, ...option.ClientOption) (*S, error)) (*S, error) {
+	// The ignore error predicate cannot return an error, so invalid patterns are reported here
+	if _, err := ignoreErrorMessageRegexps(d); err != nil {
+		return nil, err
+	}
+
+	// To get config arguments from plugin config file
+	opts, err := setSessionConfig(ctx, d.Connection)
+	if err != nil {
+		return nil, err
+	}
+
+	// The authenticated HTTP client is built first, so its transport can be wrapped. The
+	// generated client is then given only the options which are compatible with WithHTTPClient.
+	client, _, err := htransport.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	client.Transport = &retryTransport{base: client.Transport, policy: connectionErrorRetryPolicy(d.Connection)}
+	serviceOpts := []option.ClientOption{option.WithHTTPClient(client)}
+	if universeDomain := GetConfig(d.Connection).UniverseDomain; universeDomain != nil {
+		serviceOpts = append(serviceOpts, option.WithUniverseDomain(*universeDomain))
+	}
+
+	endpoint, err := endpointBasePath(d.Connection, serviceName, apiPath)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint != "" {
+		serviceOpts = append(serviceOpts, option.WithEndpoint(endpoint))
+	}
+
+	return newService(ctx, serviceOpts...)
+}
+
+// endpointBasePath returns the base path a REST client should use if the `endpoints` config
+// argument has an entry for the service, or "" if it has none. The scheme and host of the
+// configured endpoint are used with apiPath, unless the configured endpoint has a path.
+func endpointBasePath(connection *plugin.Connection, serviceName string, apiPath string) (string, error) {
+	endpoint, ok := GetConfig(connection).Endpoints[serviceName]
+	if !ok {
+		return "", nil
+	}
+
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil || endpointURL.Scheme == "" || endpointURL.Host == "" {
+		return "", sessionConfigError(connection, "endpoints", fmt.Errorf("%s endpoint %q must be an absolute URL", serviceName, endpoint))
+	}
+
+	if endpointURL.Path == "" || endpointURL.Path == "/" {
+		endpointURL.Path = "/" + apiPath
+	}
+	if !strings.HasSuffix(endpointURL.Path, "/") {
+		endpointURL.Path += "/"
+	}
+
+	return endpointURL.String(), nil
+}
+
+// sessionConfigError names the connection and config argument that could not be used to build client options
+func sessionConfigError(connection *plugin.Connection, argument string, err error) error {
+	connectionName := ""
+	if connection != nil {
+		connectionName = connection.Name
+	}
+	return fmt.Errorf("connection '%s': invalid '%s' argument: %w", connectionName, argument, err)
+}
+
+// externalAccountTokenSource builds a Workload Identity Federation token source from the
+// external_account_* config arguments. The subject token is read from a file, a URL or an
+// executable and exchanged with the Security Token Service whenever the access token expires.
+// Executable sources also require the GOOGLE_EXTERNAL_ACCOUNT_ALLOW_EXECUTABLES=1 environment variable.
+func externalAccountTokenSource(gcpConfig gcpConfig) (oauth2.TokenSource, error) {
+	credentialSource := &externalaccount.CredentialSource{}
+	sources := 0
+
+	if gcpConfig.ExternalAccountTokenFile != nil {
+		path, err := homedir.Expand(*gcpConfig.ExternalAccountTokenFile)
+		if err != nil {
+			return nil, err
+		}
+		credentialSource.File = path
+		sources++
+	}
+	if gcpConfig.ExternalAccountTokenURL != nil {
+		credentialSource.URL = *gcpConfig.ExternalAccountTokenURL
+		credentialSource.Headers = gcpConfig.ExternalAccountTokenURLHeaders
+		sources++
+	}
+	if gcpConfig.ExternalAccountTokenExecutable != nil {
+		credentialSource.Executable = &externalaccount.ExecutableConfig{
+			Command: *gcpConfig.ExternalAccountTokenExecutable,
+		}
+		sources++
+	}
+	if sources != 1 {
+		return nil, fmt.Errorf("exactly one of external_account_token_file, external_account_token_url or external_account_token_executable must be set when external_account_audience is set")
+	}
+
+	if gcpConfig.ExternalAccountTokenFormat != nil {
+		credentialSource.Format.Type = *gcpConfig.ExternalAccountTokenFormat
+	}
+	if gcpConfig.ExternalAccountTokenFieldName != nil {
+		credentialSource.Format.SubjectTokenFieldName = *gcpConfig.ExternalAccountTokenFieldName
+	}
+
+	subjectTokenType := "urn:ietf:params:oauth:token-type:jwt"
+	if gcpConfig.ExternalAccountSubjectTokenType != nil {
+		subjectTokenType = *gcpConfig.ExternalAccountSubjectTokenType
+	}
+
+	// The token source is cached along with the service clients and outlives the
+	// query that created it, so it must not be bound to the query context
+	return externalaccount.NewTokenSource(context.Background(), externalaccount.Config{
+		Audience:         *gcpConfig.ExternalAccountAudience,
+		SubjectTokenType: subjectTokenType,
+		CredentialSource: credentialSource,
+		Scopes:           []string{"https://www.googleapis.com/auth/cloud-platform"},
+		UniverseDomain:   getUniverseDomain(gcpConfig),
+	})
 }
 
 // Returns the content of given file, or the inline JSON credential as it is
@@ -296,17 +533,13 @@ func buildQueryFilter(filterQuals []filterQualMap, equalQuals plugin.KeyColumnEq
 
 				// In case of a in caluse
 				if qualValue.GetListValue() != nil {
-					filter := ""
-					for i, q := range qualValue.GetListValue().Values {
-						if i == 0 {
-							filter = fmt.Sprintf("(%s = \"%s\")", qual.PropertyPath, q.GetStringValue())
-						} else {
-							filter = fmt.Sprintf("%s OR (%s = \"%s\")", filter, qual.PropertyPath, q.GetStringValue())
-						}
+					var values []string
+					for _, q := range qualValue.GetListValue().Values {
+						values = append(values, quoteFilterValue(q.GetStringValue()))
 					}
-					filters = append(filters, fmt.Sprintf("(%s)", filter))
+					filters = append(filters, buildInFilter(qual.PropertyPath, values))
 				} else {
-					filters = append(filters, fmt.Sprintf("(%s = \"%s\")", qual.PropertyPath, qualValue.GetStringValue()))
+					filters = append(filters, fmt.Sprintf("(%s = %s)", qual.PropertyPath, quoteFilterValue(qualValue.GetStringValue())))
 				}
 			case "boolean":
 				filters = append(filters, fmt.Sprintf("(%s = %t)", qual.PropertyPath, qualValue.GetBoolValue()))
@@ -334,10 +567,24 @@ func buildQueryFilter(filterQuals []filterQualMap, equalQuals plugin.KeyColumnEq
  *
  * Output: []string{"(cpuPlatform = \"Intel Haswell\")", "((status = \"TERMINATED\") OR (status = \"RUNNING\"))", "(deletionProtection = false)"}
  *
+ * Supported filterQualMap types:
+ *  - "string": comparison operators and IN. LIKE is translated to the `eq` regular expression
+ *    syntax of the Compute Engine API, which cannot be combined with the other operators, so
+ *    LIKE filters are only returned if there are no other filters.
+ *  - "boolean": = and <>.
+ *  - "int64": comparison operators and IN, for integer and double columns.
+ *  - "timestamp": comparison operators, with the value formatted as RFC 3339.
+ *  - "labels": jsonb containment (labels @> '{"env": "prod"}') as labels.env = "prod", and
+ *    key existence (labels ? 'env') as labels.env:*.
+ *
+ * String values are quoted and escaped. Filters which can't be expressed are left out, as
+ * Postgres applies all quals to the returned rows anyway.
+ *
  * This can be used for almost all the API's in GCP if it supports filter option
  */
 func buildQueryFilterFromQuals(filterQuals []filterQualMap, equalQuals plugin.KeyColumnQualMap) []string {
 	filters := []string{}
+	regexFilters := []string{}
 
 	for _, filterQualItem := range filterQuals {
 		filterQual := equalQuals[filterQualItem.ColumnName]
@@ -354,35 +601,45 @@ func buildQueryFilterFromQuals(filterQuals []filterQualMap, equalQuals plugin.Ke
 			for _, qual := range filterQual.Quals {
 				if qual.Value != nil {
 					value := qual.Value
+					propertyPath := filterQualItem.PropertyPath
 					switch filterQualItem.Type {
 					case "string":
 						// In case of IN caluse
 						if value.GetListValue() != nil {
-							filter := ""
-							for i, q := range value.GetListValue().Values {
-								if i == 0 {
-									filter = fmt.Sprintf("(%s = \"%s\")", filterQualItem.PropertyPath, q.GetStringValue())
-								} else {
-									filter = fmt.Sprintf("%s OR (%s = \"%s\")", filter, filterQualItem.PropertyPath, q.GetStringValue())
-								}
-							}
-							filters = append(filters, fmt.Sprintf("(%s)", filter))
-						} else {
-							switch qual.Operator {
-							case "=", "<>", "!=", ">", ",":
-								filters = append(filters, fmt.Sprintf("(%s %s \"%s\")", filterQualItem.PropertyPath, GcpFilterOperatorMap[qual.Operator], value.GetStringValue()))
-							case "<=", ">=":
-								filters = append(filters, fmt.Sprintf("((%s = \"%s\") OR (%s %s \"%s\"))", filterQualItem.PropertyPath, value.GetStringValue(), filterQualItem.PropertyPath, GcpFilterOperatorMap[qual.Operator], value.GetStringValue()))
+							var values []string
+							for _, q := range value.GetListValue().Values {
+								values = append(values, quoteFilterValue(q.GetStringValue()))
 							}
+							filters = append(filters, buildListFilters(propertyPath, qual.Operator, values)...)
+						} else if qual.Operator == "~~" {
+							regexFilters = append(regexFilters, fmt.Sprintf("(%s eq %s)", propertyPath, quoteFilterValue(likeToRegex(value.GetStringValue()))))
+						} else if filter := buildComparisonFilter(propertyPath, qual.Operator, quoteFilterValue(value.GetStringValue())); filter != "" {
+							filters = append(filters, filter)
 						}
 					case "boolean":
 						boolValue := value.GetBoolValue()
 						switch qual.Operator {
 						case "<>":
-							filters = append(filters, fmt.Sprintf("(%s = %t)", filterQualItem.PropertyPath, !boolValue))
+							filters = append(filters, fmt.Sprintf("(%s = %t)", propertyPath, !boolValue))
 						case "=":
-							filters = append(filters, fmt.Sprintf("(%s = %t)", filterQualItem.PropertyPath, boolValue))
+							filters = append(filters, fmt.Sprintf("(%s = %t)", propertyPath, boolValue))
+						}
+					case "int64":
+						if value.GetListValue() != nil {
+							var values []string
+							for _, q := range value.GetListValue().Values {
+								values = append(values, formatNumberFilterValue(q))
+							}
+							filters = append(filters, buildListFilters(propertyPath, qual.Operator, values)...)
+						} else if filter := buildComparisonFilter(propertyPath, qual.Operator, formatNumberFilterValue(value)); filter != "" {
+							filters = append(filters, filter)
 						}
+					case "timestamp":
+						if filter := buildTimestampLowerBoundFilter(propertyPath, qual.Operator, value); filter != "" {
+							filters = append(filters, filter)
+						}
+					case "labels":
+						filters = append(filters, buildLabelFilters(propertyPath, qual.Operator, value)...)
 					}
 				}
 			}
@@ -390,9 +647,150 @@ func buildQueryFilterFromQuals(filterQuals []filterQualMap, equalQuals plugin.Ke
 		}
 	}
 
+	if len(filters) == 0 {
+		return regexFilters
+	}
+	return filters
+}
+
+// buildComparisonFilter returns the filter for a comparison of a property with an already formatted value
+func buildComparisonFilter(propertyPath string, operator string, value string) string {
+	switch operator {
+	case "=", "<>", "!=", ">", "<":
+		return fmt.Sprintf("(%s %s %s)", propertyPath, GcpFilterOperatorMap[operator], value)
+	case "<=", ">=":
+		return fmt.Sprintf("((%s = %s) OR (%s %s %s))", propertyPath, value, propertyPath, GcpFilterOperatorMap[operator], value)
+	}
+	return ""
+}
+
+// timestampFilterMargin is subtracted from the lower bound of a timestamp filter. It is more than
+// the largest UTC offset, so a timestamp after the bound compares as a later string whatever
+// offset the API formats it with.
+const timestampFilterMargin = 24 * time.Hour
+
+// buildTimestampLowerBoundFilter returns the filter for a > or >= qual on an RFC 3339 timestamp
+// property, e.g. the Compute creationTimestamp. The API compares the values as strings, and formats
+// them in a local time zone ("2024-01-02T10:20:30.123-08:00"), so the filter compares them with the
+// qual's UTC time less timestampFilterMargin. It can match a day of earlier resources, which
+// Postgres filters out again. Other operators are not pushed down, as no margin makes them safe.
+func buildTimestampLowerBoundFilter(propertyPath string, operator string, value *proto.QualValue) string {
+	if (operator != ">" && operator != ">=") || value.GetTimestampValue() == nil {
+		return ""
+	}
+	bound := value.GetTimestampValue().AsTime().UTC().Add(-timestampFilterMargin)
+	return fmt.Sprintf("(%s > %s)", propertyPath, quoteFilterValue(bound.Format("2006-01-02T15:04:05")))
+}
+
+// buildListFilters returns the filters for a qual with a list value: an IN clause (= ANY) is one
+// filter matching any of the values, and a NOT IN clause (<> ALL) a filter per value. Other
+// operators are not pushed down.
+func buildListFilters(propertyPath string, operator string, values []string) []string {
+	switch operator {
+	case "=":
+		return []string{buildInFilter(propertyPath, values)}
+	case "<>", "!=":
+		var filters []string
+		for _, value := range values {
+			filters = append(filters, buildComparisonFilter(propertyPath, operator, value))
+		}
+		return filters
+	}
+	return nil
+}
+
+// buildInFilter returns the filter for an IN clause, i.e. ((property = value1) OR (property = value2))
+func buildInFilter(propertyPath string, values []string) string {
+	filter := ""
+	for i, value := range values {
+		if i == 0 {
+			filter = fmt.Sprintf("(%s = %s)", propertyPath, value)
+		} else {
+			filter = fmt.Sprintf("%s OR (%s = %s)", filter, propertyPath, value)
+		}
+	}
+	return fmt.Sprintf("(%s)", filter)
+}
+
+// labelKeyRegexp matches the label keys which can be used in a filter property path
+var labelKeyRegexp = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// buildLabelFilters returns the filters for a jsonb qual on a labels column
+func buildLabelFilters(propertyPath string, operator string, value *proto.QualValue) []string {
+	var filters []string
+	switch operator {
+	case "@>":
+		var labels map[string]interface{}
+		if err := json.Unmarshal([]byte(value.GetJsonbValue()), &labels); err != nil {
+			return nil
+		}
+		keys := make([]string, 0, len(labels))
+		for key := range labels {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			labelValue, ok := labels[key].(string)
+			if !ok || !labelKeyRegexp.MatchString(key) {
+				continue
+			}
+			filters = append(filters, fmt.Sprintf("(%s.%s = %s)", propertyPath, key, quoteFilterValue(labelValue)))
+		}
+	case "?":
+		if key := value.GetStringValue(); labelKeyRegexp.MatchString(key) {
+			filters = append(filters, fmt.Sprintf("(%s.%s:*)", propertyPath, key))
+		}
+	}
 	return filters
 }
 
+// filterValueReplacer escapes the characters which would end a double quoted filter value
+var filterValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// quoteFilterValue returns a string value as a double quoted and escaped filter literal
+func quoteFilterValue(value string) string {
+	return `"` + filterValueReplacer.Replace(value) + `"`
+}
+
+// formatNumberFilterValue formats an integer or double qual value for a filter
+func formatNumberFilterValue(value *proto.QualValue) string {
+	if _, ok := value.Value.(*proto.QualValue_DoubleValue); ok {
+		return strconv.FormatFloat(value.GetDoubleValue(), 'f', -1, 64)
+	}
+	return strconv.FormatInt(value.GetInt64Value(), 10)
+}
+
+// likeToRegex translates a LIKE pattern to an RE2 regular expression matching the whole value.
+// Regular expression metacharacters, quotes and backslashes are matched with "." rather than
+// escaped, so the expression never depends on how the API unescapes the filter literal. The
+// result may match more values than the pattern, which Postgres filters out again.
+func likeToRegex(pattern string) string {
+	var regex strings.Builder
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			escaped = false
+			if strings.ContainsRune(`\.+*?()|[]{}^$"`, r) {
+				regex.WriteString(".")
+			} else {
+				regex.WriteRune(r)
+			}
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			regex.WriteString(".*")
+		case r == '_':
+			regex.WriteString(".")
+		case strings.ContainsRune(`.+*?()|[]{}^$"`, r):
+			regex.WriteString(".")
+		default:
+			regex.WriteRune(r)
+		}
+	}
+	return regex.String()
+}
+
 type filterQualMap struct {
 	ColumnName   string
 	PropertyPath string
@@ -0,0 +1,286 @@
+package gcp
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var testFilterQuals = []filterQualMap{
+	{"name", "name", "string"},
+	{"status", "status", "string"},
+	{"deletion_protection", "deletionProtection", "boolean"},
+	{"size_gb", "sizeGb", "int64"},
+	{"creation_timestamp", "creationTimestamp", "timestamp"},
+	{"labels", "labels", "labels"},
+}
+
+func testQuals(qualList ...*quals.Qual) plugin.KeyColumnQualMap {
+	qualMap := plugin.KeyColumnQualMap{}
+	for _, qual := range qualList {
+		if qualMap[qual.Column] == nil {
+			qualMap[qual.Column] = &plugin.KeyColumnQuals{Name: qual.Column}
+		}
+		qualMap[qual.Column].Quals = append(qualMap[qual.Column].Quals, qual)
+	}
+	return qualMap
+}
+
+func timestampQual(column string, operator string, value string) *quals.Qual {
+	timestamp, _ := time.Parse(time.RFC3339, value)
+	return &quals.Qual{Column: column, Operator: operator, Value: &proto.QualValue{Value: &proto.QualValue_TimestampValue{TimestampValue: timestamppb.New(timestamp)}}}
+}
+
+func stringQual(column string, operator string, value string) *quals.Qual {
+	return &quals.Qual{Column: column, Operator: operator, Value: &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: value}}}
+}
+
+func stringListQual(column string, operator string, values ...string) *quals.Qual {
+	list := &proto.QualValueList{}
+	for _, value := range values {
+		list.Values = append(list.Values, &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: value}})
+	}
+	return &quals.Qual{Column: column, Operator: operator, Value: &proto.QualValue{Value: &proto.QualValue_ListValue{ListValue: list}}}
+}
+
+func TestBuildQueryFilterFromQuals(t *testing.T) {
+	tests := []struct {
+		name  string
+		quals plugin.KeyColumnQualMap
+		want  []string
+	}{
+		{
+			name:  "string values are quoted and escaped",
+			quals: testQuals(stringQual("name", "=", `my "vm" \ 1`)),
+			want:  []string{`(name = "my \"vm\" \\ 1")`},
+		},
+		{
+			name:  "not equal",
+			quals: testQuals(stringQual("status", "<>", "RUNNING")),
+			want:  []string{`(status != "RUNNING")`},
+		},
+		{
+			name:  "less than",
+			quals: testQuals(stringQual("name", "<", "b")),
+			want:  []string{`(name < "b")`},
+		},
+		{
+			name:  "less than or equal",
+			quals: testQuals(stringQual("name", "<=", "b")),
+			want:  []string{`((name = "b") OR (name < "b"))`},
+		},
+		{
+			name: "in",
+			quals: testQuals(&quals.Qual{Column: "status", Operator: "=", Value: &proto.QualValue{Value: &proto.QualValue_ListValue{ListValue: &proto.QualValueList{Values: []*proto.QualValue{
+				{Value: &proto.QualValue_StringValue{StringValue: "RUNNING"}},
+				{Value: &proto.QualValue_StringValue{StringValue: "STOPPED"}},
+			}}}}}),
+			want: []string{`((status = "RUNNING") OR (status = "STOPPED"))`},
+		},
+		{
+			name:  "not in",
+			quals: testQuals(stringListQual("status", "<>", "RUNNING", "STOPPED")),
+			want:  []string{`(status != "RUNNING")`, `(status != "STOPPED")`},
+		},
+		{
+			name:  "list with another operator is not pushed down",
+			quals: testQuals(stringListQual("name", "<", "a", "b")),
+			want:  []string{},
+		},
+		{
+			name:  "boolean",
+			quals: testQuals(&quals.Qual{Column: "deletion_protection", Operator: "<>", Value: &proto.QualValue{Value: &proto.QualValue_BoolValue{BoolValue: true}}}),
+			want:  []string{`(deletionProtection = false)`},
+		},
+		{
+			name:  "int64",
+			quals: testQuals(&quals.Qual{Column: "size_gb", Operator: ">=", Value: &proto.QualValue{Value: &proto.QualValue_Int64Value{Int64Value: 10}}}),
+			want:  []string{`((sizeGb = 10) OR (sizeGb > 10))`},
+		},
+		{
+			// The API formats creationTimestamp in a local time zone and compares it as a string,
+			// so the bound is a day earlier in UTC and Postgres filters out the extra rows
+			name:  "timestamp lower bound",
+			quals: testQuals(timestampQual("creation_timestamp", ">=", "2024-01-02T10:20:30+02:00")),
+			want:  []string{`(creationTimestamp > "2024-01-01T08:20:30")`},
+		},
+		{
+			name:  "timestamp upper bound is not pushed down",
+			quals: testQuals(timestampQual("creation_timestamp", "<", "2024-01-02T10:20:30Z"), timestampQual("creation_timestamp", "=", "2024-01-02T10:20:30Z")),
+			want:  []string{},
+		},
+		{
+			name:  "labels containment",
+			quals: testQuals(&quals.Qual{Column: "labels", Operator: "@>", Value: &proto.QualValue{Value: &proto.QualValue_JsonbValue{JsonbValue: `{"env": "prod", "Bad Key": "x", "team": "a\"b"}`}}}),
+			want:  []string{`(labels.env = "prod")`, `(labels.team = "a\"b")`},
+		},
+		{
+			name:  "labels key exists",
+			quals: testQuals(&quals.Qual{Column: "labels", Operator: "?", Value: &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: "env"}}}),
+			want:  []string{`(labels.env:*)`},
+		},
+		{
+			name:  "like is translated to a regex",
+			quals: testQuals(stringQual("name", "~~", `web-%_1.x`)),
+			want:  []string{`(name eq "web-.*.1.x")`},
+		},
+		{
+			name:  "like escapes and metacharacters",
+			quals: testQuals(stringQual("name", "~~", `a\%b(c)"`)),
+			want:  []string{`(name eq "a%b.c..")`},
+		},
+		{
+			// The API does not allow eq regex filters to be combined with comparisons, so the
+			// regex filters are dropped and Postgres applies the LIKE
+			name:  "regex filters are dropped when mixed with comparisons",
+			quals: testQuals(stringQual("name", "~~", "web-%"), stringQual("status", "=", "RUNNING")),
+			want:  []string{`(status = "RUNNING")`},
+		},
+		{
+			name:  "unsupported operator",
+			quals: testQuals(stringQual("name", ">=", "a"), stringQual("status", "~~*", "run%")),
+			want:  []string{`((name = "a") OR (name > "a"))`},
+		},
+		{
+			name:  "no quals",
+			quals: plugin.KeyColumnQualMap{},
+			want:  []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := buildQueryFilterFromQuals(testFilterQuals, test.quals)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("buildQueryFilterFromQuals() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestBuildAIP160Filter(t *testing.T) {
+	filterQuals := []filterQualMap{
+		{"name", "name", "string"},
+		{"state", "state", "string"},
+		{"deletion_protection", "deletionProtection", "boolean"},
+		{"size_gb", "sizeGb", "int64"},
+		{"create_time", "createTime", "timestamp"},
+		{"labels", "labels", "labels"},
+	}
+
+	tests := []struct {
+		name  string
+		quals plugin.KeyColumnQualMap
+		want  string
+	}{
+		{
+			name:  "string values are quoted and escaped",
+			quals: testQuals(stringQual("name", "=", `my "db" \ 1`)),
+			want:  `name = "my \"db\" \\ 1"`,
+		},
+		{
+			name:  "comparison operators",
+			quals: testQuals(stringQual("state", "<>", "READY"), &quals.Qual{Column: "size_gb", Operator: ">=", Value: &proto.QualValue{Value: &proto.QualValue_Int64Value{Int64Value: 10}}}),
+			want:  `state != "READY" AND sizeGb >= 10`,
+		},
+		{
+			name:  "boolean",
+			quals: testQuals(&quals.Qual{Column: "deletion_protection", Operator: "=", Value: &proto.QualValue{Value: &proto.QualValue_BoolValue{BoolValue: true}}}),
+			want:  `deletionProtection = true`,
+		},
+		{
+			name:  "timestamps keep their fractional seconds",
+			quals: testQuals(timestampQual("create_time", ">", "2024-01-02T10:20:30.123456+02:00")),
+			want:  `createTime > "2024-01-02T08:20:30.123456Z"`,
+		},
+		{
+			name:  "in",
+			quals: testQuals(stringListQual("state", "=", "READY", "STOPPED")),
+			want:  `(state = "READY" OR state = "STOPPED")`,
+		},
+		{
+			name:  "not in",
+			quals: testQuals(stringListQual("state", "<>", "READY", "STOPPED")),
+			want:  `(state != "READY" AND state != "STOPPED")`,
+		},
+		{
+			name:  "list with another operator is not pushed down",
+			quals: testQuals(stringListQual("name", ">", "a", "b")),
+			want:  ``,
+		},
+		{
+			name:  "labels",
+			quals: testQuals(&quals.Qual{Column: "labels", Operator: "@>", Value: &proto.QualValue{Value: &proto.QualValue_JsonbValue{JsonbValue: `{"env": "prod"}`}}}),
+			want:  `(labels.env = "prod")`,
+		},
+		{
+			// Dataplex rejects AND without spaces around it
+			name:  "filters are joined with spaced ANDs",
+			quals: testQuals(stringQual("name", "=", "a"), stringQual("state", "=", "ACTIVE"), stringQual("labels", "?", "env")),
+			want:  `name = "a" AND state = "ACTIVE" AND (labels.env:*)`,
+		},
+		{
+			name:  "unsupported operator",
+			quals: testQuals(stringQual("name", "~~", "a%")),
+			want:  ``,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := buildAIP160Filter(filterQuals, test.quals); got != test.want {
+				t.Errorf("buildAIP160Filter() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLikeToRegex(t *testing.T) {
+	tests := map[string]string{
+		"web%":      "web.*",
+		"web_1":     "web.1",
+		`web\_1`:    "web_1",
+		`50\%`:      "50%",
+		"a.b":       "a.b",
+		"a+b*c?":    "a.b.c.",
+		`say "hi"`:  "say .hi.",
+		`back\\sl`:  "back.sl",
+		"[x]{2}^$|": ".x..2....",
+	}
+	for pattern, want := range tests {
+		if got := likeToRegex(pattern); got != want {
+			t.Errorf("likeToRegex(%q) = %q, want %q", pattern, got, want)
+		}
+	}
+}
+
+func TestEndpointBasePath(t *testing.T) {
+	connection := &plugin.Connection{Name: "gcp", Config: gcpConfig{Endpoints: map[string]string{
+		"storage": "http://localhost:4443",
+		"compute": "https://compute-myendpoint.p.googleapis.com/compute/v1",
+		"pubsub":  "localhost:8085",
+	}}}
+
+	tests := []struct {
+		serviceName string
+		apiPath     string
+		want        string
+		wantErr     bool
+	}{
+		{serviceName: "storage", apiPath: "storage/v1/", want: "http://localhost:4443/storage/v1/"},
+		{serviceName: "compute", apiPath: "compute/beta/", want: "https://compute-myendpoint.p.googleapis.com/compute/v1/"},
+		{serviceName: "dns", apiPath: "", want: ""},
+		{serviceName: "pubsub", apiPath: "", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := endpointBasePath(connection, test.serviceName, test.apiPath)
+		if (err != nil) != test.wantErr || got != test.want {
+			t.Errorf("endpointBasePath(%q) = %q, %v, want %q", test.serviceName, got, err, test.want)
+		}
+	}
+}
@@ -26,67 +26,75 @@ func BuildVertexAILocationList(clientType string) func(ctx context.Context, d *p
 		// Create Service Connection
 		service, err := AIService(ctx, d, clientType)
 		if err != nil {
-			return nil
+			return []map[string]interface{}{locationListFailure(ctx, d, "BuildVertexAILocationList", "aiplatform.projects.locations.list", "", err)}
 		}
 
-		// Get project details
-		projectData, err := activeProject(ctx, d)
+		// Get the projects the connection is scoped to
+		projects, err := getProjectList(ctx, d)
 		if err != nil {
-			return nil
+			return []map[string]interface{}{locationListFailure(ctx, d, "BuildVertexAILocationList", "aiplatform.projects.locations.list", "", err)}
 		}
-		project := projectData.Project
 
-		var resourceLocations []*location.Location
-		input := &location.ListLocationsRequest{
-			Name: "projects/" + project,
-		}
+		var matrix, failures []map[string]interface{}
+		for _, project := range projects {
+			var resourceLocations []*location.Location
+			var err error
+			input := &location.ListLocationsRequest{
+				Name: "projects/" + project,
+			}
+
+			switch clientType {
+			case "Endpoint":
+				resourceLocations, err = iterateLocationResponse(service.Endpoint.ListLocations(ctx, input))
+			case "Dataset":
+				resourceLocations, err = iterateLocationResponse(service.Dataset.ListLocations(ctx, input))
+			case "Index":
+				resourceLocations, err = iterateLocationResponse(service.Index.ListLocations(ctx, input))
+			case "Job":
+				resourceLocations, err = iterateLocationResponse(service.Job.ListLocations(ctx, input))
+			case "Model":
+				resourceLocations, err = iterateLocationResponse(service.Model.ListLocations(ctx, input))
+			case "Notebook":
+				resourceLocations, err = iterateLocationResponse(service.Notebook.ListLocations(ctx, input))
+			}
+			if err != nil {
+				failures = append(failures, locationListFailure(ctx, d, "BuildVertexAILocationList", "aiplatform.projects.locations.list", project, err))
+				continue
+			}
 
-		switch clientType {
-		case "Endpoint":
-			resp := service.Endpoint.ListLocations(ctx, input)
-			resourceLocations = append(resourceLocations, iterateLocationResponse(resp)...)
-		case "Dataset":
-			resp := service.Dataset.ListLocations(ctx, input)
-			resourceLocations = append(resourceLocations, iterateLocationResponse(resp)...)
-		case "Index":
-			resp := service.Index.ListLocations(ctx, input)
-			resourceLocations = append(resourceLocations, iterateLocationResponse(resp)...)
-		case "Job":
-			resp := service.Job.ListLocations(ctx, input)
-			resourceLocations = append(resourceLocations, iterateLocationResponse(resp)...)
-		case "Model":
-			resp := service.Model.ListLocations(ctx, input)
-			resourceLocations = append(resourceLocations, iterateLocationResponse(resp)...)
-		case "Notebook":
-			resp := service.Notebook.ListLocations(ctx, input)
-			resourceLocations = append(resourceLocations, iterateLocationResponse(resp)...)
+			for _, location := range resourceLocations {
+				if !isLocationIncluded(d.Connection, location.LocationId) {
+					continue
+				}
+				matrix = append(matrix, map[string]interface{}{matrixKeyProject: project, matrixKeyLocation: location.LocationId})
+			}
 		}
 
-		// validate location list
-		matrix := make([]map[string]interface{}, len(resourceLocations))
-		for i, location := range resourceLocations {
-			matrix[i] = map[string]interface{}{matrixKeyLocation: location.LocationId}
+		// Failures are not cached, so the next query lists the locations again
+		if len(failures) > 0 {
+			return append(matrix, failures...)
 		}
+
 		d.ConnectionManager.Cache.Set(locationCacheKey, matrix)
 		return matrix
 
 	}
 }
 
-func iterateLocationResponse(response *aiplatform.LocationIterator) []*location.Location {
+func iterateLocationResponse(response *aiplatform.LocationIterator) ([]*location.Location, error) {
 	var loc []*location.Location
 	for {
-
 		res, err := response.Next()
+		if err == iterator.Done {
+			break
+		}
 		if err != nil {
 			if strings.Contains(err.Error(), "404") {
-				return nil
-			}
-			if err == iterator.Done {
-				break
+				return nil, nil
 			}
+			return nil, err
 		}
 		loc = append(loc, res)
 	}
-	return loc
+	return loc, nil
 }